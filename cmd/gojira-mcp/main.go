@@ -7,6 +7,8 @@
 //	JIRA_BASE_URL    - Jira server URL (e.g., https://company.atlassian.net)
 //	JIRA_USERNAME    - Jira username or email
 //	JIRA_API_TOKEN   - Jira API token or password
+//	JIRA_PAT         - Jira Server/Data Center Personal Access Token; used
+//	                   instead of JIRA_USERNAME and JIRA_API_TOKEN when set
 //
 // Usage with Claude Code:
 //
@@ -48,18 +50,26 @@ func main() {
 	baseURL := os.Getenv("JIRA_BASE_URL")
 	username := os.Getenv("JIRA_USERNAME")
 	apiToken := os.Getenv("JIRA_API_TOKEN")
+	pat := os.Getenv("JIRA_PAT")
 
-	if baseURL == "" || username == "" || apiToken == "" {
+	if baseURL == "" || (pat == "" && (username == "" || apiToken == "")) {
 		logger.Error("missing required environment variables",
 			"JIRA_BASE_URL", baseURL != "",
 			"JIRA_USERNAME", username != "",
-			"JIRA_API_TOKEN", apiToken != "")
-		fmt.Fprintln(os.Stderr, "Error: JIRA_BASE_URL, JIRA_USERNAME, and JIRA_API_TOKEN are required")
+			"JIRA_API_TOKEN", apiToken != "",
+			"JIRA_PAT", pat != "")
+		fmt.Fprintln(os.Stderr, "Error: JIRA_BASE_URL and either JIRA_PAT, or JIRA_USERNAME and JIRA_API_TOKEN, are required")
 		os.Exit(1)
 	}
 
 	// Create Jira client
-	client, err := rest.NewClientFromBasicAuth(baseURL, username, apiToken, false)
+	var client *rest.Client
+	var err error
+	if pat != "" {
+		client, err = rest.NewClientFromBearerToken(baseURL, pat, false)
+	} else {
+		client, err = rest.NewClientFromBasicAuth(baseURL, username, apiToken, false)
+	}
 	if err != nil {
 		logger.Error("failed to create Jira client", "error", err)
 		fmt.Fprintf(os.Stderr, "Error creating Jira client: %v\n", err)
@@ -1,11 +1,13 @@
 package main
 
 import (
+	"context"
 	"errors"
 	"os"
 	"path/filepath"
 	"strings"
 
+	"github.com/grokify/gojira"
 	"github.com/grokify/gojira/rest"
 )
 
@@ -14,65 +16,137 @@ const (
 	EnvJiraURL   = "JIRA_URL"
 	EnvJiraUser  = "JIRA_USER"
 	EnvJiraToken = "JIRA_TOKEN"
+	EnvJiraPAT   = "JIRA_PAT" // Personal Access Token for Jira Server/Data Center bearer auth
+
+	// Environment variable names for Jira Cloud OAuth 2.0 (3LO) authentication.
+	EnvJiraOAuthClientID     = "JIRA_OAUTH_CLIENT_ID"
+	EnvJiraOAuthClientSecret = "JIRA_OAUTH_CLIENT_SECRET" //nolint:gosec // This is an env var name, not credentials
+	EnvJiraCloudID           = "JIRA_CLOUD_ID"
 
 	// Default goauth credentials file path (this is a path, not credentials).
 	DefaultGoauthCredsFile = "~/.config/goauth/credentials.json" //nolint:gosec // This is a path constant, not credentials
+
+	// Default OAuth 2.0 token file written by `gojira auth login`, next to the goauth credentials file.
+	DefaultOAuth2TokenFile = "~/.config/goauth/gojira_oauth2_token.json" //nolint:gosec // This is a path constant, not credentials
 )
 
 // AuthOptions holds authentication configuration options.
 type AuthOptions struct {
-	CredsFile string // Path to goauth credentials file
-	Account   string // Account key within the credentials file
+	CredsFile    string  // Path to goauth credentials file
+	Account      string  // Account key within the credentials file
+	RateLimit    float64 // Client-side requests per second; 0 disables rate limiting
+	RateBurst    int     // Burst size for the client-side rate limiter
+	RateAdaptive bool    // Slow down when Jira returns rate-limit headers
+	Workflow     string  // Path to a status config YAML file; see `gojira.ReadFileStatusCategoryConfig`
+}
+
+// ClientOptions returns `rest.ClientOption` values for non-authentication settings.
+func (opts *AuthOptions) ClientOptions() []rest.ClientOption {
+	if opts == nil || opts.RateLimit <= 0 {
+		return nil
+	}
+	return []rest.ClientOption{
+		rest.WithRateLimiter(rest.NewRateLimiter(opts.RateLimit, opts.RateBurst, opts.RateAdaptive)),
+	}
 }
 
 // NewClientFromOptions creates a Jira client using the following priority:
 // 1. CLI flags (credsFile, account)
-// 2. Environment variables (JIRA_URL, JIRA_USER, JIRA_TOKEN)
-// 3. Default goauth file (~/.config/goauth/credentials.json) with interactive selection
+// 2. Environment variables (JIRA_URL with JIRA_PAT, or JIRA_URL, JIRA_USER, JIRA_TOKEN)
+// 3. OAuth 2.0 token from `gojira auth login` (JIRA_OAUTH_CLIENT_ID, JIRA_OAUTH_CLIENT_SECRET)
+// 4. Default goauth file (~/.config/goauth/credentials.json) with interactive selection
+// If a workflow file is set, it is loaded as the client's status config.
 func NewClientFromOptions(opts *AuthOptions) (*rest.Client, error) {
+	if opts == nil || strings.TrimSpace(opts.Workflow) == "" {
+		return newClientFromOptions(opts)
+	}
+	scc, err := gojira.ReadFileStatusCategoryConfig(expandPath(strings.TrimSpace(opts.Workflow)))
+	if err != nil {
+		return nil, err
+	}
+	client, err := newClientFromOptions(opts)
+	if err != nil {
+		return nil, err
+	}
+	if client.Config == nil {
+		client.Config = gojira.NewConfigDefault()
+	}
+	client.Config.StatusConfig = scc
+	return client, nil
+}
+
+func newClientFromOptions(opts *AuthOptions) (*rest.Client, error) {
+	clientOpts := opts.ClientOptions()
+
 	// 1. Check CLI flags for explicit credentials file
 	if opts != nil && strings.TrimSpace(opts.CredsFile) != "" {
 		credsFile := expandPath(opts.CredsFile)
-		return rest.NewClientGoauthBasicAuthFile(credsFile, opts.Account, false)
+		return rest.NewClientGoauthCredentialsSetFile(credsFile, opts.Account, false, true, clientOpts...)
 	}
 
 	// 2. Check environment variables
 	url := strings.TrimSpace(os.Getenv(EnvJiraURL))
 	user := strings.TrimSpace(os.Getenv(EnvJiraUser))
 	token := strings.TrimSpace(os.Getenv(EnvJiraToken))
+	pat := strings.TrimSpace(os.Getenv(EnvJiraPAT))
 
-	if url != "" && user != "" && token != "" {
-		return rest.NewClientFromBasicAuth(url, user, token, false)
+	if url != "" && pat != "" {
+		return rest.NewClientFromBearerToken(url, pat, false, clientOpts...)
+	} else if url != "" && user != "" && token != "" {
+		return rest.NewClientFromBasicAuth(url, user, token, false, clientOpts...)
 	}
 
-	// 3. Check if default goauth file exists
+	// 3. Check for an OAuth 2.0 token saved by `gojira auth login`
+	if clientID := strings.TrimSpace(os.Getenv(EnvJiraOAuthClientID)); clientID != "" {
+		tokenPath := expandPath(DefaultOAuth2TokenFile)
+		if _, err := os.Stat(tokenPath); err == nil {
+			return rest.NewClientFromOAuth2(context.Background(), oauth2ConfigFromEnv(clientID),
+				rest.NewFileTokenStore(tokenPath), false, clientOpts...)
+		}
+	}
+
+	// 4. Check if default goauth file exists
 	defaultPath := expandPath(DefaultGoauthCredsFile)
 	if _, err := os.Stat(defaultPath); err == nil {
 		// File exists, try to use it
 		if opts != nil && opts.Account != "" {
-			return rest.NewClientGoauthBasicAuthFile(defaultPath, opts.Account, false)
+			return rest.NewClientGoauthCredentialsSetFile(defaultPath, opts.Account, false, true, clientOpts...)
 		}
 		// Fall through to interactive CLI selection
 	}
 
-	// 4. Fall back to goauth CLI (interactive selection)
-	return rest.NewClientFromGoauthCLI(true, false)
+	// 5. Fall back to goauth CLI (interactive selection)
+	return rest.NewClientFromGoauthCLI(true, false, clientOpts...)
 }
 
 // NewClientFromEnv creates a Jira client from environment variables only.
+// JIRA_PAT takes precedence over JIRA_USER and JIRA_TOKEN when set.
 // Returns an error if required environment variables are not set.
 func NewClientFromEnv() (*rest.Client, error) {
 	url := strings.TrimSpace(os.Getenv(EnvJiraURL))
 	user := strings.TrimSpace(os.Getenv(EnvJiraUser))
 	token := strings.TrimSpace(os.Getenv(EnvJiraToken))
+	pat := strings.TrimSpace(os.Getenv(EnvJiraPAT))
 
-	if url == "" || user == "" || token == "" {
-		return nil, errors.New("environment variables JIRA_URL, JIRA_USER, and JIRA_TOKEN must all be set")
+	if url != "" && pat != "" {
+		return rest.NewClientFromBearerToken(url, pat, false)
+	} else if url == "" || user == "" || token == "" {
+		return nil, errors.New("environment variables JIRA_URL and JIRA_PAT, or JIRA_URL, JIRA_USER, and JIRA_TOKEN must be set")
 	}
 
 	return rest.NewClientFromBasicAuth(url, user, token, false)
 }
 
+// oauth2ConfigFromEnv returns the OAuth 2.0 client configuration from environment variables.
+// JIRA_URL, if set, selects the Jira Cloud site when the token has access to more than one.
+func oauth2ConfigFromEnv(clientID string) rest.OAuth2Config {
+	return rest.OAuth2Config{
+		ClientID:     clientID,
+		ClientSecret: strings.TrimSpace(os.Getenv(EnvJiraOAuthClientSecret)),
+		CloudID:      strings.TrimSpace(os.Getenv(EnvJiraCloudID)),
+		SiteURL:      strings.TrimSpace(os.Getenv(EnvJiraURL))}
+}
+
 // expandPath expands ~ to the user's home directory.
 func expandPath(path string) string {
 	if strings.HasPrefix(path, "~/") {
@@ -0,0 +1,145 @@
+package main
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/spf13/cobra"
+
+	"github.com/grokify/gojira/rest"
+)
+
+var boardCmd = &cobra.Command{
+	Use:   "board",
+	Short: "List boards, board columns and sprints",
+	Long: `List Jira Software boards, board columns and sprints using the Agile API.
+
+Examples:
+  # Scrum boards for a project
+  gojira board list --project FOO --type scrum --table
+
+  # Board columns and the statuses mapped to them
+  gojira board columns 42 --table
+
+  # Active and future sprints
+  gojira board sprints 42 --state active,future`,
+}
+
+var boardListCmd = &cobra.Command{
+	Use:   "list",
+	Short: "List boards",
+	Args:  cobra.NoArgs,
+	RunE:  runBoardList,
+}
+
+var boardColumnsCmd = &cobra.Command{
+	Use:   "columns <board-id>",
+	Short: "Show board columns and their statuses",
+	Args:  cobra.ExactArgs(1),
+	RunE:  runBoardColumns,
+}
+
+var boardSprintsCmd = &cobra.Command{
+	Use:   "sprints <board-id>",
+	Short: "List a board's sprints",
+	Args:  cobra.ExactArgs(1),
+	RunE:  runBoardSprints,
+}
+
+var (
+	boardProject string
+	boardType    string
+	boardName    string
+	boardState   string
+)
+
+func init() {
+	rootCmd.AddCommand(boardCmd)
+	boardCmd.AddCommand(boardListCmd, boardColumnsCmd, boardSprintsCmd)
+
+	boardListCmd.Flags().StringVar(&boardProject, "project", "", "Project key or ID")
+	boardListCmd.Flags().StringVar(&boardType, "type", "", "Board type: scrum, kanban or simple")
+	boardListCmd.Flags().StringVar(&boardName, "name", "", "Boards whose name contains this value")
+	boardSprintsCmd.Flags().StringVar(&boardState, "state", "", "Comma-separated sprint states: active, future, closed (default: all)")
+}
+
+func runBoardList(cmd *cobra.Command, args []string) error {
+	client, err := NewClientFromOptions(getAuthOptions())
+	if err != nil {
+		return fmt.Errorf("authentication failed: %w", err)
+	}
+	boards, err := client.BoardAPI.Boards(context.Background(), &rest.BoardListOptions{
+		ProjectKeyOrID: boardProject,
+		Type:           boardType,
+		Name:           boardName})
+	if err != nil {
+		return fmt.Errorf("list boards failed: %w", err)
+	}
+	if getOutputFormat() == OutputTable {
+		fmt.Printf("%8s  %-8s  %s\n", "ID", "TYPE", "NAME")
+		fmt.Printf("%s  %s  %s\n", strings.Repeat("-", 8), strings.Repeat("-", 8), strings.Repeat("-", 40))
+		for _, b := range boards {
+			fmt.Printf("%8d  %-8s  %s\n", b.ID, b.Type, b.Name)
+		}
+		return nil
+	}
+	return outputResult(cmd, boards)
+}
+
+func runBoardColumns(cmd *cobra.Command, args []string) error {
+	boardID, err := parseAgileID(args[0], "board")
+	if err != nil {
+		return err
+	}
+	client, err := NewClientFromOptions(getAuthOptions())
+	if err != nil {
+		return fmt.Errorf("authentication failed: %w", err)
+	}
+	cols, err := client.BoardAPI.Columns(context.Background(), boardID)
+	if err != nil {
+		return fmt.Errorf("get board columns failed: %w", err)
+	}
+	if getOutputFormat() == OutputTable {
+		fmt.Printf("%-20s  %-9s  %s\n", "COLUMN", "WIP LIMIT", "STATUSES")
+		fmt.Printf("%s  %s  %s\n", strings.Repeat("-", 20), strings.Repeat("-", 9), strings.Repeat("-", 40))
+		for _, col := range cols {
+			limit := ""
+			if col.Min > 0 || col.Max > 0 {
+				limit = fmt.Sprintf("%d-%d", col.Min, col.Max)
+			}
+			fmt.Printf("%-20s  %-9s  %s\n", truncateString(col.Name, 20), limit, strings.Join(col.Statuses, ", "))
+		}
+		return nil
+	}
+	return outputResult(cmd, cols)
+}
+
+func runBoardSprints(cmd *cobra.Command, args []string) error {
+	boardID, err := parseAgileID(args[0], "board")
+	if err != nil {
+		return err
+	}
+	client, err := NewClientFromOptions(getAuthOptions())
+	if err != nil {
+		return fmt.Errorf("authentication failed: %w", err)
+	}
+	sprints, err := client.BoardAPI.Sprints(context.Background(), boardID, rest.ParseFieldsCSV(boardState)...)
+	if err != nil {
+		return fmt.Errorf("list sprints failed: %w", err)
+	}
+	if getOutputFormat() == OutputTable {
+		writeSprintsTable(sprints)
+		return nil
+	}
+	return outputResult(cmd, sprints)
+}
+
+func parseAgileID(s, name string) (int, error) {
+	id, err := strconv.Atoi(strings.TrimSpace(s))
+	if err != nil || id <= 0 {
+		return 0, fmt.Errorf("invalid %s id %q", name, s)
+	}
+	return id, nil
+}
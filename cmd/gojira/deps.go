@@ -0,0 +1,180 @@
+package main
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"os"
+	"strings"
+
+	"github.com/spf13/cobra"
+
+	"github.com/grokify/gojira"
+	"github.com/grokify/gojira/rest"
+)
+
+var depsCmd = &cobra.Command{
+	Use:   "deps <issue-key>",
+	Short: "Show an issue's dependencies from issue links",
+	Long: `Show an issue's links, its unresolved direct and transitive blockers, the
+critical path of blockers weighted by remaining estimate, blocked issues and
+blocking cycles.
+
+Linked issues are fetched up to --depth links away. An issue is resolved if it
+has a resolution or its status is in the Done category.
+
+Examples:
+  # What is blocking ABC-123?
+  gojira deps ABC-123 --table
+
+  # Follow links further and count unresolved children as blockers of an epic
+  gojira deps ABC-100 --depth 5 --children
+
+  # Treat "Depends" links as blocking as well
+  gojira deps ABC-123 --link-types Blocks,Depends`,
+	Args: cobra.ExactArgs(1),
+	RunE: runDeps,
+}
+
+var (
+	depsDepth     int
+	depsChildren  bool
+	depsLinkTypes []string
+)
+
+func init() {
+	rootCmd.AddCommand(depsCmd)
+	depsCmd.Flags().IntVar(&depsDepth, "depth", 3, "Levels of linked issues to fetch")
+	depsCmd.Flags().BoolVar(&depsChildren, "children", false, "Fetch children and treat unresolved children as blockers of their parent")
+	depsCmd.Flags().StringSliceVar(&depsLinkTypes, "link-types", []string{rest.LinkTypeBlocks}, "Link type names that block, comma-separated")
+}
+
+// DepsLink is a link to or from the issue.
+type DepsLink struct {
+	Relation string `json:"relation"` // e.g. `blocks`, `is blocked by`
+	Key      string `json:"key"`
+	Summary  string `json:"summary,omitempty"`
+	Status   string `json:"status,omitempty"`
+	Resolved bool   `json:"resolved"`
+}
+
+// DepsResult is the output of the deps command.
+type DepsResult struct {
+	Issue              rest.IssueNode      `json:"issue"`
+	Links              []DepsLink          `json:"links"`
+	Blockers           []string            `json:"blockers"`
+	TransitiveBlockers []string            `json:"transitiveBlockers"`
+	CriticalPath       []string            `json:"criticalPath"`
+	CriticalPathHours  float64             `json:"criticalPathHours"`
+	Blocked            []rest.BlockedIssue `json:"blocked"`
+	Cycles             [][]string          `json:"cycles"`
+	Unloaded           []string            `json:"unloaded,omitempty"` // linked issues beyond --depth or not visible
+}
+
+var depsFields = []string{"summary", "issuetype", "status", "resolution", "timeestimate", "parent", "issuelinks"}
+
+func runDeps(cmd *cobra.Command, args []string) error {
+	key := strings.ToUpper(strings.TrimSpace(args[0]))
+	client, err := NewClientFromOptions(getAuthOptions())
+	if err != nil {
+		return fmt.Errorf("authentication failed: %w", err)
+	}
+	if !flagQuiet {
+		fmt.Fprintf(os.Stderr, "Reading linked issues...\n")
+	}
+	ctx := context.Background()
+	so := rest.SearchOptions{Fields: depsFields}
+	jql := gojira.JQL{KeysIncl: [][]string{{key}}}.String()
+	if depsChildren {
+		jql = fmt.Sprintf("%s OR parent = %s", jql, key)
+	}
+	ii, err := rest.CollectIssues(client.IssueAPI.SearchIssuesSeq(ctx, jql, so))
+	if err != nil {
+		return fmt.Errorf("search failed: %w", err)
+	}
+	set := rest.NewIssuesSet(client.Config)
+	if err := set.Add(ii...); err != nil {
+		return err
+	} else if _, ok := set.Items[key]; !ok {
+		return fmt.Errorf("issue not found (%s)", key)
+	} else if err := client.IssueAPI.IssuesSetLoadLinked(ctx, set, depsDepth, so); err != nil {
+		return fmt.Errorf("loading linked issues failed: %w", err)
+	}
+
+	g := rest.NewIssueGraph(set, &rest.IssueGraphOptions{
+		BlockingLinkTypes:    depsLinkTypes,
+		ChildrenBlockParents: depsChildren})
+	result := &DepsResult{
+		Issue:              *g.Nodes[key],
+		Blockers:           g.DirectBlockers(key, true),
+		TransitiveBlockers: g.TransitiveBlockers(key, true),
+		Blocked:            g.Blocked(),
+		Cycles:             g.Cycles(),
+		Unloaded:           g.KeysUnloaded()}
+	for _, e := range g.EdgesForKey(key) {
+		link := DepsLink{Relation: e.Outward, Key: e.To}
+		if e.To == key {
+			link.Relation, link.Key = e.Inward, e.From
+		}
+		if n := g.Nodes[link.Key]; n != nil {
+			link.Summary, link.Status, link.Resolved = n.Summary, n.Status, n.Resolved
+		}
+		result.Links = append(result.Links, link)
+	}
+	path, total, err := g.CriticalPath(key)
+	if err != nil && !errors.Is(err, rest.ErrIssueGraphCycle) {
+		return err
+	}
+	result.CriticalPath = path
+	result.CriticalPathHours = total.Hours()
+
+	if getOutputFormat() == OutputTable {
+		writeDepsTable(result)
+		return nil
+	}
+	return outputResult(cmd, result)
+}
+
+func writeDepsTable(r *DepsResult) {
+	resolved := func(b bool) string {
+		if b {
+			return "resolved"
+		}
+		return ""
+	}
+	fmt.Printf("%s  %s  [%s] %s\n\n", r.Issue.Key, r.Issue.Type, r.Issue.Status, r.Issue.Summary)
+	fmt.Printf("%-20s  %-12s  %-16s  %-8s  %s\n", "RELATION", "KEY", "STATUS", "", "SUMMARY")
+	fmt.Printf("%s  %s  %s  %s  %s\n", strings.Repeat("-", 20), strings.Repeat("-", 12), strings.Repeat("-", 16),
+		strings.Repeat("-", 8), strings.Repeat("-", 30))
+	for _, l := range r.Links {
+		fmt.Printf("%-20s  %-12s  %-16s  %-8s  %s\n", truncateString(l.Relation, 20), truncateString(l.Key, 12),
+			truncateString(l.Status, 16), resolved(l.Resolved), truncateString(l.Summary, 40))
+	}
+	fmt.Printf("\nBlockers: %s\n", depsKeys(r.Blockers))
+	fmt.Printf("Transitive blockers: %s\n", depsKeys(r.TransitiveBlockers))
+	if len(r.CriticalPath) > 0 {
+		fmt.Printf("Critical path (%.1fh remaining): %s\n", r.CriticalPathHours, strings.Join(r.CriticalPath, " -> "))
+	}
+	if len(r.Blocked) > 0 {
+		fmt.Printf("\nBlocked issues:\n")
+		for _, b := range r.Blocked {
+			fmt.Printf("  %s blocked by %s\n", b.Key, strings.Join(b.Blockers, ", "))
+		}
+	}
+	if len(r.Cycles) > 0 {
+		fmt.Printf("\nBlocking cycles:\n")
+		for _, c := range r.Cycles {
+			fmt.Printf("  %s\n", strings.Join(c, " <-> "))
+		}
+	}
+	if len(r.Unloaded) > 0 {
+		fmt.Printf("\nNot loaded (beyond --depth or not visible): %s\n", strings.Join(r.Unloaded, ", "))
+	}
+}
+
+func depsKeys(keys []string) string {
+	if len(keys) == 0 {
+		return "none"
+	}
+	return strings.Join(keys, ", ")
+}
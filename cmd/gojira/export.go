@@ -1,20 +1,27 @@
 package main
 
 import (
+	"bufio"
+	"context"
 	"encoding/json"
 	"fmt"
+	"io"
 	"os"
 	"path/filepath"
 	"strings"
 
+	jira "github.com/andygrunwald/go-jira"
 	"github.com/grokify/gojira/rest"
 	"github.com/spf13/cobra"
 )
 
 var exportCmd = &cobra.Command{
 	Use:   "export [flags]",
-	Short: "Export issues to JSON or XLSX",
-	Long: `Export Jira issues to JSON or XLSX format.
+	Short: "Export issues to JSON, NDJSON or XLSX",
+	Long: `Export Jira issues to JSON, NDJSON or XLSX format.
+
+NDJSON export streams one issue per line as pages are received, so large
+result sets are not held in memory. Use "-" to write NDJSON to stdout.
 
 Examples:
   # Export search results to JSON
@@ -30,7 +37,13 @@ Examples:
   gojira export --from-json issues.json --xlsx output.xlsx
 
   # Export specific issues by key
-  gojira export --keys ISSUE-1,ISSUE-2,ISSUE-3 --json output.json`,
+  gojira export --keys ISSUE-1,ISSUE-2,ISSUE-3 --json output.json
+
+  # Stream a large export as newline-delimited JSON
+  gojira export --jql "project = FOO" --ndjson issues.ndjson
+
+  # Export only some fields
+  gojira export --jql "project = FOO" --fields key,summary,status,assignee --ndjson issues.ndjson`,
 	RunE: runExport,
 }
 
@@ -39,9 +52,12 @@ var (
 	exportKeys           string
 	exportJSONOutput     string
 	exportXLSXOutput     string
+	exportNDJSONOutput   string
 	exportFromJSON       string
 	exportIncludeParents bool
 	exportSheetName      string
+	exportFields         string
+	exportExpand         string
 )
 
 func init() {
@@ -51,15 +67,23 @@ func init() {
 	exportCmd.Flags().StringVar(&exportKeys, "keys", "", "Comma-separated issue keys to export")
 	exportCmd.Flags().StringVar(&exportJSONOutput, "json", "", "Output JSON file path")
 	exportCmd.Flags().StringVar(&exportXLSXOutput, "xlsx", "", "Output XLSX file path")
+	exportCmd.Flags().StringVar(&exportNDJSONOutput, "ndjson", "", "Output NDJSON file path, streamed one issue per line (\"-\" for stdout)")
 	exportCmd.Flags().StringVar(&exportFromJSON, "from-json", "", "Read issues from existing JSON file instead of querying")
 	exportCmd.Flags().BoolVar(&exportIncludeParents, "include-parents", false, "Include parent issues in export")
 	exportCmd.Flags().StringVar(&exportSheetName, "sheet", "issues", "Sheet name for XLSX export")
+	exportCmd.Flags().StringVarP(&exportFields, "fields", "f", "", "Comma-separated list of fields to request (default all fields)")
+	exportCmd.Flags().StringVar(&exportExpand, "expand", "", "Comma-separated list of expansions, e.g. changelog,renderedFields")
 }
 
 func runExport(cmd *cobra.Command, args []string) error {
 	// Validate output flags
-	if exportJSONOutput == "" && exportXLSXOutput == "" {
-		return fmt.Errorf("at least one output format required: --json or --xlsx")
+	if exportNDJSONOutput != "" {
+		if exportJSONOutput != "" || exportXLSXOutput != "" || exportIncludeParents || exportFromJSON != "" {
+			return fmt.Errorf("--ndjson cannot be combined with --json, --xlsx, --include-parents or --from-json")
+		}
+		return runExportNDJSON()
+	} else if exportJSONOutput == "" && exportXLSXOutput == "" {
+		return fmt.Errorf("at least one output format required: --json, --ndjson or --xlsx")
 	}
 
 	var issuesSet *rest.IssuesSet
@@ -139,7 +163,8 @@ func fetchIssuesForExport() (*rest.IssuesSet, error) {
 			fmt.Fprintf(os.Stderr, "Fetching %d issues...\n", len(keys))
 		}
 
-		issuesSet, err := client.IssueAPI.SearchIssuesSet(fmt.Sprintf("key in (%s)", strings.Join(keys, ",")))
+		issuesSet, err := client.IssueAPI.SearchIssuesSet(fmt.Sprintf("key in (%s)", strings.Join(keys, ",")),
+			searchOptionsFromFlags(exportFields, exportExpand))
 		if err != nil {
 			return nil, fmt.Errorf("failed to fetch issues: %w", err)
 		}
@@ -151,7 +176,7 @@ func fetchIssuesForExport() (*rest.IssuesSet, error) {
 		fmt.Fprintf(os.Stderr, "Searching issues with JQL: %s\n", exportJQL)
 	}
 
-	issuesSet, err := client.IssueAPI.SearchIssuesSet(exportJQL)
+	issuesSet, err := client.IssueAPI.SearchIssuesSet(exportJQL, searchOptionsFromFlags(exportFields, exportExpand))
 	if err != nil {
 		return nil, fmt.Errorf("search failed: %w", err)
 	}
@@ -163,6 +188,65 @@ func fetchIssuesForExport() (*rest.IssuesSet, error) {
 	return issuesSet, nil
 }
 
+// runExportNDJSON streams search results to the NDJSON output without collecting
+// them into an `IssuesSet`.
+func runExportNDJSON() error {
+	jql := exportJQL
+	if exportKeys != "" {
+		keys := parseKeys(exportKeys)
+		if len(keys) == 0 {
+			return fmt.Errorf("no valid issue keys provided")
+		}
+		jql = fmt.Sprintf("key in (%s)", strings.Join(keys, ","))
+	} else if jql == "" {
+		return fmt.Errorf("query required: use --jql or --keys")
+	}
+
+	client, err := NewClientFromOptions(getAuthOptions())
+	if err != nil {
+		return fmt.Errorf("authentication failed: %w", err)
+	}
+
+	w := io.Writer(os.Stdout)
+	var f *os.File
+	var bw *bufio.Writer
+	if exportNDJSONOutput != "-" {
+		if dir := filepath.Dir(exportNDJSONOutput); dir != "" && dir != "." {
+			if err := os.MkdirAll(dir, 0755); err != nil {
+				return fmt.Errorf("failed to create directory: %w", err)
+			}
+		}
+		if f, err = os.OpenFile(exportNDJSONOutput, os.O_CREATE|os.O_WRONLY|os.O_TRUNC, 0600); err != nil {
+			return fmt.Errorf("failed to create NDJSON file: %w", err)
+		}
+		defer f.Close() // on error; closed and checked below on success
+		bw = bufio.NewWriter(f)
+		w = bw
+	}
+
+	if !flagQuiet {
+		fmt.Fprintf(os.Stderr, "Searching issues with JQL: %s\n", jql)
+	}
+
+	seq := client.IssueAPI.SearchIssuesSeq(context.Background(), jql, searchOptionsFromFlags(exportFields, exportExpand))
+	count, err := writeIssuesNDJSON(w, seq, 0,
+		func(iss *jira.Issue) any { return iss })
+	if err != nil {
+		return fmt.Errorf("export failed after %d issues: %w", count, err)
+	} else if bw != nil {
+		if err := bw.Flush(); err != nil {
+			return fmt.Errorf("failed to write NDJSON file: %w", err)
+		} else if err := f.Close(); err != nil {
+			return fmt.Errorf("failed to close NDJSON file: %w", err)
+		}
+	}
+
+	if !flagQuiet {
+		fmt.Fprintf(os.Stderr, "Wrote %d issues to %s\n", count, exportNDJSONOutput)
+	}
+	return nil
+}
+
 func parseKeys(keysStr string) []string {
 	var keys []string
 	for _, k := range strings.Split(keysStr, ",") {
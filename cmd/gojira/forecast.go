@@ -0,0 +1,243 @@
+package main
+
+import (
+	"errors"
+	"fmt"
+	"math"
+	"os"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/grokify/mogo/time/timeutil"
+	"github.com/spf13/cobra"
+
+	"github.com/grokify/gojira/forecast"
+	"github.com/grokify/gojira/rest"
+)
+
+var forecastCmd = &cobra.Command{
+	Use:   "forecast",
+	Short: "Forecast delivery with a Monte Carlo simulation",
+	Long: `Forecast delivery from historical throughput using a Monte Carlo simulation.
+
+Throughput is the number of issues from --jql resolved in each day or week of
+the history window (--from to --to, default the last 12 weeks). The forecast
+starts the day after --to and answers:
+
+  - When will the remaining items be done? (--remaining-jql or --remaining)
+  - How many items will be done by a date? (--date)
+
+Results are given at each confidence level. Use the same --seed to reproduce a
+forecast.
+
+Examples:
+  # When will the open stories in the epic be done?
+  gojira forecast --jql "project = FOO AND resolved >= -90d" \
+    --remaining-jql "parent = FOO-100 AND resolution is EMPTY" --table
+
+  # How many items by the end of the quarter?
+  gojira forecast --jql "project = FOO AND resolved >= -90d" --date 2026-12-31
+
+  # Daily throughput, custom confidence levels
+  gojira forecast --jql "project = FOO" --remaining 60 --interval day --confidence 70,90`,
+	RunE: runForecast,
+}
+
+var (
+	forecastJQL          string
+	forecastRemainingJQL string
+	forecastRemaining    int
+	forecastDate         string
+	forecastFrom         string
+	forecastTo           string
+	forecastInterval     string
+	forecastTrials       int
+	forecastSeed         uint64
+	forecastConfidence   string
+)
+
+func init() {
+	rootCmd.AddCommand(forecastCmd)
+
+	forecastCmd.Flags().StringVar(&forecastJQL, "jql", "", "JQL query for historical issues; resolved issues give throughput (required)")
+	forecastCmd.Flags().StringVar(&forecastRemainingJQL, "remaining-jql", "", "JQL query for the remaining issues to forecast")
+	forecastCmd.Flags().IntVar(&forecastRemaining, "remaining", 0, "Number of remaining items, instead of --remaining-jql")
+	forecastCmd.Flags().StringVar(&forecastDate, "date", "", "Forecast how many items are done by this date (YYYY-MM-DD)")
+	forecastCmd.Flags().StringVar(&forecastFrom, "from", "", "History start date YYYY-MM-DD (default: 12 weeks before --to)")
+	forecastCmd.Flags().StringVar(&forecastTo, "to", "", "History end date YYYY-MM-DD (default: today)")
+	forecastCmd.Flags().StringVar(&forecastInterval, "interval", "week", "Throughput interval: day or week")
+	forecastCmd.Flags().IntVar(&forecastTrials, "trials", forecast.TrialsDefault, "Number of simulation trials")
+	forecastCmd.Flags().Uint64Var(&forecastSeed, "seed", 1, "Random seed")
+	forecastCmd.Flags().StringVar(&forecastConfidence, "confidence", "50,85,95", "Comma-separated confidence levels in percent")
+
+	_ = forecastCmd.MarkFlagRequired("jql")
+}
+
+// ForecastOutput represents the full forecast output.
+type ForecastOutput struct {
+	Interval       string                   `json:"interval"`
+	HistoryStart   string                   `json:"historyStart"`
+	HistoryEnd     string                   `json:"historyEnd"`
+	Throughput     []int                    `json:"throughput"`
+	ThroughputMean float64                  `json:"throughputMean"`
+	Trials         int                      `json:"trials"`
+	Seed           uint64                   `json:"seed"`
+	Start          string                   `json:"start"`
+	Remaining      *int                     `json:"remaining,omitempty"`
+	When           []forecast.WhenResult    `json:"when,omitempty"`
+	Date           string                   `json:"date,omitempty"`
+	HowMany        []forecast.HowManyResult `json:"howMany,omitempty"`
+}
+
+func runForecast(cmd *cobra.Command, args []string) error {
+	hasRemaining := forecastRemainingJQL != "" || cmd.Flags().Changed("remaining")
+	if !hasRemaining && forecastDate == "" {
+		return errors.New("set --remaining-jql, --remaining or --date")
+	} else if forecastRemainingJQL != "" && cmd.Flags().Changed("remaining") {
+		return errors.New("use only one of --remaining-jql and --remaining")
+	}
+	interval, err := parseForecastInterval(forecastInterval)
+	if err != nil {
+		return err
+	}
+	confidences, err := parseConfidences(forecastConfidence)
+	if err != nil {
+		return err
+	}
+	to, err := parseReportDate(forecastTo, false)
+	if err != nil {
+		return fmt.Errorf("invalid --to: %w", err)
+	} else if to.IsZero() {
+		now := time.Now().UTC()
+		to = time.Date(now.Year(), now.Month(), now.Day(), 0, 0, 0, 0, time.UTC)
+	}
+	end := to.AddDate(0, 0, 1)
+	from, err := parseReportDate(forecastFrom, false)
+	if err != nil {
+		return fmt.Errorf("invalid --from: %w", err)
+	} else if from.IsZero() {
+		from = end.AddDate(0, 0, -12*7)
+	}
+	var until time.Time
+	if forecastDate != "" {
+		if until, err = parseReportDate(forecastDate, false); err != nil {
+			return fmt.Errorf("invalid --date: %w", err)
+		}
+		until = until.AddDate(0, 0, 1) // include the whole day
+	}
+
+	client, err := NewClientFromOptions(getAuthOptions())
+	if err != nil {
+		return fmt.Errorf("authentication failed: %w", err)
+	}
+
+	if !flagQuiet {
+		fmt.Fprintf(os.Stderr, "Searching issues...\n")
+	}
+	set, err := client.IssueAPI.SearchIssuesSet(forecastJQL, rest.SearchOptions{Fields: []string{"status", "resolutiondate"}})
+	if err != nil {
+		return fmt.Errorf("search failed: %w", err)
+	}
+	tp, err := forecast.ThroughputFromIssuesSet(set, from, end, interval)
+	if err != nil {
+		return err
+	}
+	if !flagQuiet {
+		fmt.Fprintf(os.Stderr, "Found %d issues, %d resolved in history window\n", set.Len(), tp.Total())
+	}
+
+	mc := forecast.NewMonteCarlo(tp, forecastSeed)
+	mc.Trials = forecastTrials
+	output := ForecastOutput{
+		Interval:       interval.String(),
+		HistoryStart:   tp.Start.Format(time.DateOnly),
+		HistoryEnd:     tp.End().AddDate(0, 0, -1).Format(time.DateOnly),
+		Throughput:     tp.Counts,
+		ThroughputMean: math.Round(tp.Mean()*100) / 100,
+		Trials:         mc.Trials,
+		Seed:           forecastSeed,
+		Start:          end.Format(time.DateOnly)}
+
+	if hasRemaining {
+		remaining := forecastRemaining
+		if forecastRemainingJQL != "" {
+			rset, err := client.IssueAPI.SearchIssuesSet(forecastRemainingJQL, rest.SearchOptions{Fields: []string{"status"}})
+			if err != nil {
+				return fmt.Errorf("remaining search failed: %w", err)
+			}
+			remaining = rset.Len()
+		}
+		output.Remaining = &remaining
+		if output.When, err = mc.When(remaining, end, confidences); err != nil {
+			return err
+		}
+	}
+	if !until.IsZero() {
+		output.Date = forecastDate
+		if output.HowMany, err = mc.HowManyByDate(end, until, confidences); err != nil {
+			return err
+		}
+	}
+
+	if getOutputFormat() == OutputTable {
+		writeForecastTable(output)
+		return nil
+	}
+	return outputResult(cmd, output)
+}
+
+func parseForecastInterval(s string) (timeutil.Interval, error) {
+	switch strings.ToLower(strings.TrimSpace(s)) {
+	case "day", "daily":
+		return timeutil.IntervalDay, nil
+	case "", "week", "weekly":
+		return timeutil.IntervalWeek, nil
+	default:
+		return timeutil.IntervalWeek, fmt.Errorf("invalid --interval %q: use day or week", s)
+	}
+}
+
+// parseConfidences parses comma-separated percentages such as `50,85,95`. Values up to 1 are
+// read as fractions.
+func parseConfidences(s string) ([]float64, error) {
+	var out []float64
+	for _, part := range rest.ParseFieldsCSV(s) {
+		c, err := strconv.ParseFloat(strings.TrimSuffix(part, "%"), 64)
+		if err != nil {
+			return nil, fmt.Errorf("invalid --confidence %q: %w", part, err)
+		} else if c > 1 {
+			c /= 100
+		}
+		if c <= 0 || c > 1 {
+			return nil, fmt.Errorf("invalid --confidence %q: use a percentage from 1 to 100", part)
+		}
+		out = append(out, c)
+	}
+	if len(out) == 0 {
+		return forecast.DefaultConfidences(), nil
+	}
+	return out, nil
+}
+
+func writeForecastTable(output ForecastOutput) {
+	fmt.Printf("Throughput per %s: mean %.2f over %d intervals (%s to %s)\n",
+		output.Interval, output.ThroughputMean, len(output.Throughput), output.HistoryStart, output.HistoryEnd)
+	fmt.Printf("Forecast from %s, %d trials\n", output.Start, output.Trials)
+	if output.Remaining != nil {
+		fmt.Printf("\nWhen will %d items be done?\n\n", *output.Remaining)
+		fmt.Printf("%-10s  %8s  %-10s\n", "CONFIDENCE", "PERIODS", "DATE")
+		fmt.Printf("%s  %s  %s\n", strings.Repeat("-", 10), strings.Repeat("-", 8), strings.Repeat("-", 10))
+		for _, r := range output.When {
+			fmt.Printf("%9.0f%%  %8d  %-10s\n", r.Confidence*100, r.Periods, r.Date.Format(time.DateOnly))
+		}
+	}
+	if output.Date != "" {
+		fmt.Printf("\nHow many items by %s?\n\n", output.Date)
+		fmt.Printf("%-10s  %8s\n", "CONFIDENCE", "ITEMS")
+		fmt.Printf("%s  %s\n", strings.Repeat("-", 10), strings.Repeat("-", 8))
+		for _, r := range output.HowMany {
+			fmt.Printf("%9.0f%%  %8d\n", r.Confidence*100, r.Items)
+		}
+	}
+}
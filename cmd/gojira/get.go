@@ -10,7 +10,7 @@ import (
 )
 
 var (
-	flagGetExpand bool
+	flagGetExpand string
 	flagGetFields string
 	flagGetRaw    bool
 )
@@ -30,6 +30,9 @@ Examples:
   # Get with changelog expansion
   gojira get ISSUE-123 --expand
 
+  # Get only some fields, with other expansions
+  gojira get ISSUE-123 --fields summary,status --expand=renderedFields,names
+
   # Output as table
   gojira get ISSUE-123 --table
 
@@ -42,8 +45,9 @@ Examples:
 func init() {
 	rootCmd.AddCommand(getCmd)
 
-	getCmd.Flags().BoolVar(&flagGetExpand, "expand", false, "Expand changelog and other fields")
-	getCmd.Flags().StringVarP(&flagGetFields, "fields", "f", "", "Comma-separated list of fields to include")
+	getCmd.Flags().StringVar(&flagGetExpand, "expand", "", "Comma-separated list of expansions (\"changelog\" if given without a value)")
+	getCmd.Flags().Lookup("expand").NoOptDefVal = rest.ExpandFieldChangelog
+	getCmd.Flags().StringVarP(&flagGetFields, "fields", "f", "", "Comma-separated list of fields to request (default all fields)")
 	getCmd.Flags().BoolVar(&flagGetRaw, "raw", false, "Output full API JSON instead of simplified metadata")
 }
 
@@ -56,7 +60,8 @@ func runGet(cmd *cobra.Command, args []string) error {
 
 	// Set up query options
 	opts := &rest.GetQueryOptions{
-		ExpandChangelog: flagGetExpand,
+		Expand: rest.ParseFieldsCSV(flagGetExpand),
+		Fields: rest.ParseFieldsCSV(flagGetFields),
 	}
 
 	ctx := context.Background()
@@ -0,0 +1,119 @@
+package main
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"slices"
+
+	"github.com/spf13/cobra"
+
+	"github.com/grokify/gojira/rest"
+)
+
+var graphCmd = &cobra.Command{
+	Use:   "graph",
+	Short: "Render issue hierarchies and dependencies as Mermaid or Graphviz DOT",
+	Long: `Render the issues matching a JQL query, their parents and their issue links as
+a Mermaid flowchart or a Graphviz DOT graph.
+
+Nodes are colored by status category and link to the issue in Jira. Parent/child
+edges are solid; issue links are dashed and labeled, e.g. "blocks". Linked issues
+that are not fetched have dashed borders.
+
+Examples:
+  # Epic tree for a planning doc
+  gojira graph --jql "project = FOO AND fixVersion = 2.0" --cluster epic > plan.mmd
+
+  # Dependency map rendered with Graphviz
+  gojira graph --jql "project = FOO AND sprint in openSprints()" --format dot --link-types Blocks \
+    --depth 1 -o deps.dot && dot -Tsvg deps.dot -o deps.svg
+
+  # Left to right, grouped by project, with statuses in labels
+  gojira graph --jql "key in (FOO-1, BAR-2)" --direction LR --cluster project --label key,status`,
+	Args: cobra.NoArgs,
+	RunE: runGraph,
+}
+
+var (
+	graphJQL       string
+	graphFormat    string
+	graphCluster   string
+	graphDirection string
+	graphLabels    []string
+	graphLinkTypes []string
+	graphParents   bool
+	graphDepth     int
+	graphOutput    string
+)
+
+func init() {
+	rootCmd.AddCommand(graphCmd)
+	graphCmd.Flags().StringVar(&graphJQL, "jql", "", "JQL query (required)")
+	graphCmd.Flags().StringVar(&graphFormat, "format", rest.GraphFormatMermaid, "Output format: mermaid or dot")
+	graphCmd.Flags().StringVar(&graphCluster, "cluster", "", "Group nodes by project or epic")
+	graphCmd.Flags().StringVar(&graphDirection, "direction", rest.GraphDirectionTopBottom, "Layout direction: TB or LR")
+	graphCmd.Flags().StringSliceVar(&graphLabels, "label", []string{"key", "type", "summary"}, "Node label fields: key, type, summary, status")
+	graphCmd.Flags().StringSliceVar(&graphLinkTypes, "link-types", nil, "Link type names to draw, comma-separated (default: all)")
+	graphCmd.Flags().BoolVar(&graphParents, "parents", true, "Fetch parents of the issues")
+	graphCmd.Flags().IntVar(&graphDepth, "depth", 0, "Levels of linked issues to fetch")
+	graphCmd.Flags().StringVarP(&graphOutput, "output", "o", "", "Output file (default: stdout)")
+	_ = graphCmd.MarkFlagRequired("jql")
+}
+
+var graphFields = []string{"summary", "issuetype", "status", "resolution", "project", "parent", "issuelinks"}
+
+func runGraph(cmd *cobra.Command, args []string) error {
+	if graphCluster != "" && graphCluster != rest.GraphClusterProject && graphCluster != rest.GraphClusterEpic {
+		return fmt.Errorf("invalid --cluster (%s): use project or epic", graphCluster)
+	}
+	client, err := NewClientFromOptions(getAuthOptions())
+	if err != nil {
+		return fmt.Errorf("authentication failed: %w", err)
+	}
+	if !flagQuiet {
+		fmt.Fprintf(os.Stderr, "Searching issues...\n")
+	}
+	ctx := context.Background()
+	so := rest.SearchOptions{Fields: graphFields}
+	ii, err := rest.CollectIssues(client.IssueAPI.SearchIssuesSeq(ctx, graphJQL, so))
+	if err != nil {
+		return fmt.Errorf("search failed: %w", err)
+	}
+	set := rest.NewIssuesSet(client.Config)
+	if err := set.Add(ii...); err != nil {
+		return err
+	}
+	if graphDepth > 0 {
+		if err := client.IssueAPI.IssuesSetLoadLinked(ctx, set, graphDepth, so); err != nil {
+			return fmt.Errorf("loading linked issues failed: %w", err)
+		}
+	}
+	if graphParents {
+		if set.Parents, err = client.IssueAPI.SearchIssuesSetParents(set); err != nil {
+			return fmt.Errorf("loading parents failed: %w", err)
+		}
+	}
+
+	out, err := rest.NewIssueGraph(set, nil).Render(graphFormat, &rest.IssueGraphRenderOptions{
+		ServerURL:    client.Config.ServerURL,
+		LabelSummary: slices.Contains(graphLabels, "summary"),
+		LabelType:    slices.Contains(graphLabels, "type"),
+		LabelStatus:  slices.Contains(graphLabels, "status"),
+		Cluster:      graphCluster,
+		Direction:    graphDirection,
+		LinkTypes:    graphLinkTypes})
+	if err != nil {
+		return err
+	}
+	if graphOutput != "" {
+		if err := os.WriteFile(graphOutput, []byte(out), 0600); err != nil {
+			return fmt.Errorf("failed to write graph: %w", err)
+		} else if !flagQuiet {
+			fmt.Fprintf(os.Stderr, "Wrote %s\n", graphOutput)
+		}
+		return nil
+	}
+	_, err = fmt.Print(out)
+	return err
+}
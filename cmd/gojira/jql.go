@@ -0,0 +1,141 @@
+package main
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"os"
+	"strings"
+
+	"github.com/spf13/cobra"
+	toon "github.com/toon-format/toon-go"
+
+	"github.com/grokify/gojira"
+	"github.com/grokify/gojira/rest"
+)
+
+var jqlCmd = &cobra.Command{
+	Use:   "jql",
+	Short: "Work with JQL queries",
+}
+
+var jqlLintCmd = &cobra.Command{
+	Use:   "lint <jql>",
+	Short: "Check a JQL query for errors and likely mistakes",
+	Long: `Check a JQL query with Jira's JQL parser and against the instance's fields,
+projects, statuses and issue types. Reports syntax errors, unknown fields and
+values with suggestions, deprecated "Epic Link" usage and queries that are not
+limited to projects, issues, a sprint or a date range.
+
+On Jira Server and Data Center, which do not have the JQL parser endpoint, the
+local parser is used. With --offline, nothing is read from Jira, and fields are
+only checked against --fields-json.
+
+Exits with an error if the query has errors. Warnings do not fail.
+
+Examples:
+  gojira jql lint "project = FOO AND stauts = 'In Progres'"
+  gojira jql lint "\"Epic Link\" = FOO-1" --offline
+  gojira jql lint "project = FOO" --offline --fields-json fields.json --json`,
+	Args: cobra.ExactArgs(1),
+	RunE: runJQLLint,
+}
+
+var (
+	jqlLintOffline    bool
+	jqlLintFieldsJSON string
+)
+
+func init() {
+	rootCmd.AddCommand(jqlCmd)
+	jqlCmd.AddCommand(jqlLintCmd)
+	jqlLintCmd.Flags().BoolVar(&jqlLintOffline, "offline", false, "Use only the local parser without connecting to Jira")
+	jqlLintCmd.Flags().StringVar(&jqlLintFieldsJSON, "fields-json", "", "Custom field definitions from 'gojira fields --json' for --offline")
+}
+
+// JQLLintResult is the output of the jql lint command.
+type JQLLintResult struct {
+	JQL      string                 `json:"jql"`
+	Valid    bool                   `json:"valid"` // no errors; there may be warnings
+	Warnings gojira.JQLLintWarnings `json:"warnings"`
+}
+
+func runJQLLint(cmd *cobra.Command, args []string) error {
+	jql := strings.TrimSpace(args[0])
+	var ws gojira.JQLLintWarnings
+	if jqlLintOffline {
+		cat := &gojira.JQLLintCatalog{}
+		if jqlLintFieldsJSON != "" {
+			cfs, err := readCustomFieldSetJSON(jqlLintFieldsJSON)
+			if err != nil {
+				return fmt.Errorf("failed to read fields JSON file: %w", err)
+			}
+			for _, cf := range cfs.Data {
+				cat.Fields = append(cat.Fields, cf.Name)
+				cat.Fields = append(cat.Fields, cf.ClauseNames...)
+			}
+		}
+		ws = gojira.LintJQL(jql, cat)
+	} else {
+		client, err := NewClientFromOptions(getAuthOptions())
+		if err != nil {
+			return fmt.Errorf("authentication failed: %w", err)
+		}
+		if ws, err = client.LintJQL(context.Background(), jql, nil); err != nil {
+			return fmt.Errorf("jql lint failed: %w", err)
+		}
+	}
+
+	res := JQLLintResult{JQL: jql, Valid: !ws.HasErrors(), Warnings: ws}
+	if res.Warnings == nil {
+		res.Warnings = gojira.JQLLintWarnings{}
+	}
+	switch getOutputFormat() {
+	case OutputTable:
+		writeJQLLintWarnings(os.Stdout, jql, ws)
+		if len(ws) == 0 {
+			fmt.Println("No problems found")
+		}
+	case OutputTOON:
+		data, err := toon.Marshal(res)
+		if err != nil {
+			return err
+		}
+		fmt.Println(string(data))
+	default:
+		if err := outputResult(cmd, res); err != nil {
+			return err
+		}
+	}
+	if !res.Valid {
+		return fmt.Errorf("jql has errors")
+	}
+	return nil
+}
+
+// writeJQLLintWarnings writes one warning per line, each with the query and a caret under the
+// position if it has one.
+func writeJQLLintWarnings(w io.Writer, jql string, ws gojira.JQLLintWarnings) {
+	lines := strings.Split(jql, "\n")
+	for _, lw := range ws {
+		fmt.Fprintln(w, lw.String())
+		if p := lw.Pos; p.Line > 0 && p.Line <= len(lines) {
+			fmt.Fprintf(w, "  %s\n  %s^\n", lines[p.Line-1], strings.Repeat(" ", max(0, p.Column-1)))
+		}
+	}
+}
+
+// lintSearchJQL checks a query before it is searched, writing warnings to stderr. It returns an
+// error if the query has errors.
+func lintSearchJQL(ctx context.Context, client *rest.Client, jql string) error {
+	ws, err := client.LintJQL(ctx, jql, nil)
+	if err != nil {
+		return fmt.Errorf("jql lint failed: %w", err)
+	} else if !flagQuiet || ws.HasErrors() {
+		writeJQLLintWarnings(os.Stderr, jql, ws)
+	}
+	if ws.HasErrors() {
+		return fmt.Errorf("jql has errors; run 'gojira jql lint' for details")
+	}
+	return nil
+}
@@ -0,0 +1,233 @@
+package main
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net"
+	"net/http"
+	"net/url"
+	"os"
+	"os/exec"
+	"runtime"
+	"strings"
+	"time"
+
+	"github.com/grokify/gojira/rest"
+	"github.com/spf13/cobra"
+)
+
+var (
+	flagLoginClientID     string
+	flagLoginClientSecret string
+	flagLoginRedirectURL  string
+	flagLoginScopes       []string
+	flagLoginNoBrowser    bool
+	flagLoginTimeout      time.Duration
+)
+
+var authCmd = &cobra.Command{
+	Use:   "auth",
+	Short: "Manage authentication",
+	Long:  `Manage authentication for Jira Cloud OAuth 2.0 (3LO).`,
+}
+
+var authLoginCmd = &cobra.Command{
+	Use:   "login",
+	Short: "Log in to Jira Cloud with OAuth 2.0 (3LO)",
+	Long: `Runs the OAuth 2.0 authorization code flow for Jira Cloud in the browser
+and saves the token to ~/.config/goauth/gojira_oauth2_token.json, next to the
+goauth credentials file.
+
+The redirect URL must be registered as the callback URL of your Atlassian
+OAuth 2.0 app and must use localhost or 127.0.0.1. After logging in, set
+JIRA_OAUTH_CLIENT_ID and JIRA_OAUTH_CLIENT_SECRET so other commands use the
+saved token, which is refreshed automatically. If the token has access to more
+than one site, set JIRA_URL or JIRA_CLOUD_ID to choose the site.
+
+Examples:
+  # Log in using client credentials from the environment
+  export JIRA_OAUTH_CLIENT_ID=your-client-id
+  export JIRA_OAUTH_CLIENT_SECRET=your-client-secret
+  gojira auth login
+
+  # Use a different registered callback URL
+  gojira auth login --redirect-url http://localhost:9000/callback
+
+  # Print the authorization URL instead of opening a browser
+  gojira auth login --no-browser`,
+	RunE: runAuthLogin,
+}
+
+func init() {
+	rootCmd.AddCommand(authCmd)
+	authCmd.AddCommand(authLoginCmd)
+
+	authLoginCmd.Flags().StringVar(&flagLoginClientID, "client-id", "", "OAuth 2.0 client ID (default $JIRA_OAUTH_CLIENT_ID)")
+	authLoginCmd.Flags().StringVar(&flagLoginClientSecret, "client-secret", "", "OAuth 2.0 client secret (default $JIRA_OAUTH_CLIENT_SECRET)")
+	authLoginCmd.Flags().StringVar(&flagLoginRedirectURL, "redirect-url", "http://localhost:8085/callback", "Loopback callback URL registered with the OAuth 2.0 app")
+	authLoginCmd.Flags().StringSliceVar(&flagLoginScopes, "scopes", rest.OAuth2ScopesDefault, "OAuth 2.0 scopes to request")
+	authLoginCmd.Flags().BoolVar(&flagLoginNoBrowser, "no-browser", false, "Print the authorization URL without opening a browser")
+	authLoginCmd.Flags().DurationVar(&flagLoginTimeout, "timeout", 5*time.Minute, "Time to wait for the browser callback")
+}
+
+// authLoginResult is the output of `gojira auth login`.
+type authLoginResult struct {
+	TokenFile string                    `json:"tokenFile"`
+	Expiry    time.Time                 `json:"expiry"`
+	Refresh   bool                      `json:"refreshToken"`
+	Sites     []rest.AccessibleResource `json:"sites"`
+}
+
+func runAuthLogin(cmd *cobra.Command, args []string) error {
+	cfg := rest.OAuth2Config{
+		ClientID:     firstNonEmpty(flagLoginClientID, os.Getenv(EnvJiraOAuthClientID)),
+		ClientSecret: firstNonEmpty(flagLoginClientSecret, os.Getenv(EnvJiraOAuthClientSecret)),
+		RedirectURL:  flagLoginRedirectURL,
+		Scopes:       flagLoginScopes}
+	if cfg.ClientID == "" || cfg.ClientSecret == "" {
+		return fmt.Errorf("client ID and secret are required via --client-id/--client-secret or %s/%s",
+			EnvJiraOAuthClientID, EnvJiraOAuthClientSecret)
+	}
+
+	redirect, err := url.Parse(cfg.RedirectURL)
+	if err != nil {
+		return fmt.Errorf("invalid redirect URL: %w", err)
+	} else if redirect.Scheme != "http" || (redirect.Hostname() != "localhost" && redirect.Hostname() != "127.0.0.1") {
+		return fmt.Errorf("redirect URL must be an http loopback URL: (%s)", cfg.RedirectURL)
+	}
+
+	state, err := randomState()
+	if err != nil {
+		return err
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), flagLoginTimeout)
+	defer cancel()
+
+	code, err := waitForAuthCode(ctx, redirect, state, func() {
+		authURL := cfg.AuthCodeURL(state)
+		fmt.Fprintf(os.Stderr, "Open this URL in your browser to authorize gojira:\n\n  %s\n\n", authURL)
+		if !flagLoginNoBrowser {
+			if err := openBrowser(authURL); err != nil && !flagQuiet {
+				fmt.Fprintf(os.Stderr, "Could not open browser: %v\n", err)
+			}
+		}
+	})
+	if err != nil {
+		return err
+	}
+
+	tok, err := cfg.OAuth2().Exchange(ctx, code)
+	if err != nil {
+		return fmt.Errorf("failed to exchange authorization code: %w", err)
+	}
+
+	tokenFile := expandPath(DefaultOAuth2TokenFile)
+	if err := rest.NewFileTokenStore(tokenFile).SaveToken(tok); err != nil {
+		return fmt.Errorf("failed to save token: %w", err)
+	}
+
+	sites, err := rest.GetAccessibleResources(ctx, cfg.OAuth2().Client(ctx, tok))
+	if err != nil {
+		return fmt.Errorf("failed to get accessible sites: %w", err)
+	}
+
+	enc := json.NewEncoder(cmd.OutOrStdout())
+	enc.SetIndent("", "  ")
+	return enc.Encode(authLoginResult{
+		TokenFile: tokenFile,
+		Expiry:    tok.Expiry,
+		Refresh:   tok.RefreshToken != "",
+		Sites:     sites})
+}
+
+// waitForAuthCode serves the loopback redirect URL and returns the authorization
+// code once the browser is redirected back. ready is called once the listener is up.
+func waitForAuthCode(ctx context.Context, redirect *url.URL, state string, ready func()) (string, error) {
+	ln, err := net.Listen("tcp", redirect.Host)
+	if err != nil {
+		return "", fmt.Errorf("failed to listen on (%s): %w", redirect.Host, err)
+	}
+
+	type result struct {
+		code string
+		err  error
+	}
+	results := make(chan result, 1)
+	path := redirect.Path
+	if path == "" {
+		path = "/"
+	}
+
+	mux := http.NewServeMux()
+	mux.HandleFunc(path, func(w http.ResponseWriter, r *http.Request) {
+		q := r.URL.Query()
+		var res result
+		switch {
+		case q.Get("state") != state:
+			res.err = errors.New("oauth2 state mismatch")
+		case q.Get("error") != "":
+			res.err = fmt.Errorf("authorization failed: %s: %s", q.Get("error"), q.Get("error_description"))
+		case q.Get("code") == "":
+			res.err = errors.New("authorization code missing from callback")
+		default:
+			res.code = q.Get("code")
+		}
+		if res.err != nil {
+			http.Error(w, res.err.Error(), http.StatusBadRequest)
+		} else {
+			fmt.Fprintln(w, "gojira is authorized. You can close this window.")
+		}
+		select {
+		case results <- res:
+		default:
+		}
+	})
+
+	srv := &http.Server{Handler: mux, ReadHeaderTimeout: 10 * time.Second}
+	go func() { _ = srv.Serve(ln) }()
+	defer func() { _ = srv.Close() }()
+
+	ready()
+
+	select {
+	case <-ctx.Done():
+		return "", fmt.Errorf("timed out waiting for authorization: %w", ctx.Err())
+	case res := <-results:
+		return res.code, res.err
+	}
+}
+
+func randomState() (string, error) {
+	b := make([]byte, 16)
+	if _, err := rand.Read(b); err != nil {
+		return "", err
+	}
+	return hex.EncodeToString(b), nil
+}
+
+func openBrowser(u string) error {
+	var cmd *exec.Cmd
+	switch runtime.GOOS {
+	case "darwin":
+		cmd = exec.Command("open", u)
+	case "windows":
+		cmd = exec.Command("rundll32", "url.dll,FileProtocolHandler", u)
+	default:
+		cmd = exec.Command("xdg-open", u)
+	}
+	return cmd.Start()
+}
+
+func firstNonEmpty(vals ...string) string {
+	for _, v := range vals {
+		if v = strings.TrimSpace(v); v != "" {
+			return v
+		}
+	}
+	return ""
+}
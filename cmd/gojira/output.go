@@ -4,6 +4,7 @@ import (
 	"encoding/json"
 	"fmt"
 	"io"
+	"iter"
 	"os"
 	"strings"
 
@@ -81,6 +82,40 @@ func WriteIssuesRaw(issues rest.Issues) error {
 	return enc.Encode(issues)
 }
 
+// writeIssuesNDJSON streams issues from seq to w as newline-delimited JSON, converting each
+// issue with fn. It stops after limit issues if limit > 0 and returns the number written.
+func writeIssuesNDJSON(w io.Writer, seq iter.Seq2[jira.Issue, error], limit int, fn func(*jira.Issue) any) (int, error) {
+	enc := json.NewEncoder(w)
+	count := 0
+	for iss, err := range seq {
+		if err != nil {
+			return count, err
+		} else if err := enc.Encode(fn(&iss)); err != nil {
+			return count, err
+		}
+		count++
+		if limit > 0 && count >= limit {
+			break
+		}
+	}
+	return count, nil
+}
+
+// collectIssuesMax reads issues from seq, stopping after limit issues if limit > 0.
+func collectIssuesMax(seq iter.Seq2[jira.Issue, error], limit int) (rest.Issues, error) {
+	issues := rest.Issues{}
+	for iss, err := range seq {
+		if err != nil {
+			return issues, err
+		}
+		issues = append(issues, iss)
+		if limit > 0 && len(issues) >= limit {
+			break
+		}
+	}
+	return issues, nil
+}
+
 // writeIssuesTable outputs issues as an ASCII table.
 func writeIssuesTable(issues rest.Issues, w io.Writer) error {
 	tw := tablewriter.NewWriter(w)
@@ -92,12 +92,8 @@ func runPatch(cmd *cobra.Command, args []string) error {
 		return fmt.Errorf("patch failed: %w", err)
 	}
 
-	if resp.StatusCode >= 200 && resp.StatusCode < 300 {
-		if !flagQuiet {
-			fmt.Printf("Successfully updated %s (status: %d)\n", issueKey, resp.StatusCode)
-		}
-	} else {
-		return fmt.Errorf("patch returned status %d", resp.StatusCode)
+	if !flagQuiet {
+		fmt.Printf("Successfully updated %s (status: %d)\n", issueKey, resp.StatusCode)
 	}
 
 	// Optionally show the updated issue
@@ -0,0 +1,237 @@
+package main
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"strings"
+
+	"github.com/spf13/cobra"
+	toon "github.com/toon-format/toon-go"
+
+	"github.com/grokify/gojira"
+)
+
+var queryCmd = &cobra.Command{
+	Use:   "query",
+	Short: "Run saved queries from a query catalog",
+	Long: `Run named, parameterized JQL queries from a YAML query catalog, so standard
+queries can be versioned and shared. Parameters are written as {{name}} and
+replaced with --param values, then the defaults in the catalog. Values are
+quoted or escaped so they cannot add clauses.
+
+Example catalog:
+
+  title: Team FOO Queries
+  params:
+    project: FOO
+  queries:
+    - name: Open bugs
+      key: open-bugs
+      description: Unresolved bugs, highest priority first
+      jql: project = {{project}} AND type = Bug AND resolution IS EMPTY ORDER BY priority DESC
+
+Examples:
+  gojira query list --catalog queries.yaml --table
+  gojira query run open-bugs --catalog queries.yaml --param project=BAR --table
+  gojira query report --catalog queries.yaml -o docs/queries.md`,
+}
+
+var queryListCmd = &cobra.Command{
+	Use:   "list",
+	Short: "List the queries in a catalog",
+	Args:  cobra.NoArgs,
+	RunE:  runQueryList,
+}
+
+var queryRunCmd = &cobra.Command{
+	Use:   "run <name-or-key>",
+	Short: "Search with a saved query",
+	Args:  cobra.ExactArgs(1),
+	RunE:  runQueryRun,
+}
+
+var queryReportCmd = &cobra.Command{
+	Use:   "report",
+	Short: "Write a Markdown report of the catalog's queries with live issue counts",
+	Args:  cobra.NoArgs,
+	RunE:  runQueryReport,
+}
+
+var (
+	queryCatalog      string
+	queryParams       []string
+	queryMax          int
+	queryAll          bool
+	queryFields       string
+	queryOutput       string
+	queryHeaderPrefix string
+	queryTimeZone     string
+	queryExplicitURLs bool
+)
+
+func init() {
+	rootCmd.AddCommand(queryCmd)
+	queryCmd.AddCommand(queryListCmd, queryRunCmd, queryReportCmd)
+	queryCmd.PersistentFlags().StringVarP(&queryCatalog, "catalog", "c", "queries.yaml", "Query catalog YAML file")
+	queryCmd.PersistentFlags().StringArrayVarP(&queryParams, "param", "p", nil, "Parameter value as name=value; repeatable")
+
+	queryRunCmd.Flags().IntVarP(&queryMax, "max", "m", 50, "Maximum number of results")
+	queryRunCmd.Flags().BoolVarP(&queryAll, "all", "a", false, "Retrieve all results (paginate automatically)")
+	queryRunCmd.Flags().StringVarP(&queryFields, "fields", "f", "", "Comma-separated list of fields to request (default all fields)")
+
+	queryReportCmd.Flags().StringVarP(&queryOutput, "output", "o", "", "Output file (default: stdout)")
+	queryReportCmd.Flags().StringVar(&queryHeaderPrefix, "header-prefix", "## ", "Markdown prefix for each query's heading")
+	queryReportCmd.Flags().StringVar(&queryTimeZone, "tz", "", "Time zone for query times, e.g. America/New_York (default local time)")
+	queryReportCmd.Flags().BoolVar(&queryExplicitURLs, "explicit-urls", false, "Add each search URL on its own line, e.g. for Confluence")
+}
+
+// QueryListItem is a query in the output of the query list command.
+type QueryListItem struct {
+	Name        string   `json:"name"`
+	Key         string   `json:"key,omitempty"`
+	Description string   `json:"description,omitempty"`
+	Params      []string `json:"params,omitempty"`
+	JQL         string   `json:"jql"` // with parameters replaced where values are known
+}
+
+// readQueryCatalog reads --catalog and parses --param.
+func readQueryCatalog() (*gojira.JQLCatalog, map[string]string, error) {
+	cat, err := gojira.ReadFileJQLCatalog(queryCatalog)
+	if err != nil {
+		return nil, nil, err
+	}
+	params := map[string]string{}
+	for _, p := range queryParams {
+		name, value, ok := strings.Cut(p, "=")
+		if name = strings.TrimSpace(name); !ok || name == "" {
+			return nil, nil, fmt.Errorf("invalid --param (%s): use name=value", p)
+		}
+		params[name] = value
+	}
+	return cat, params, nil
+}
+
+func runQueryList(cmd *cobra.Command, args []string) error {
+	cat, params, err := readQueryCatalog()
+	if err != nil {
+		return err
+	}
+	var items []QueryListItem
+	for _, q := range cat.Queries {
+		// Queries with missing parameters are listed with the placeholders left empty.
+		j, _ := q.JQLWithParams(cat.Params, params)
+		items = append(items, QueryListItem{
+			Name:        q.Name,
+			Key:         q.Key,
+			Description: q.Description,
+			Params:      q.ParamNames(),
+			JQL:         j.String()})
+	}
+
+	switch getOutputFormat() {
+	case OutputTable:
+		for _, item := range items {
+			title := item.Name
+			if item.Key != "" {
+				title += " (" + item.Key + ")"
+			}
+			fmt.Println(title)
+			if item.Description != "" {
+				fmt.Println("  " + item.Description)
+			}
+			if len(item.Params) > 0 {
+				fmt.Println("  Params: " + strings.Join(item.Params, ", "))
+			}
+		}
+		return nil
+	case OutputTOON:
+		data, err := toon.Marshal(items)
+		if err != nil {
+			return err
+		}
+		fmt.Println(string(data))
+		return nil
+	default:
+		return outputResult(cmd, items)
+	}
+}
+
+func runQueryRun(cmd *cobra.Command, args []string) error {
+	cat, params, err := readQueryCatalog()
+	if err != nil {
+		return err
+	}
+	j, err := cat.JQL(args[0], params)
+	if err != nil {
+		return err
+	}
+	jql := j.String()
+	client, err := NewClientFromOptions(getAuthOptions())
+	if err != nil {
+		return fmt.Errorf("failed to create Jira client: %w", err)
+	}
+	if !flagQuiet {
+		fmt.Fprintf(os.Stderr, "JQL: %s\n", jql)
+	}
+
+	limit := queryMax
+	if queryAll {
+		limit = 0
+	}
+	issues, err := collectIssuesMax(client.IssueAPI.SearchIssuesSeq(context.Background(), jql,
+		searchOptionsFromFlags(queryFields, "")), limit)
+	if err != nil {
+		return fmt.Errorf("search failed: %w", err)
+	} else if len(issues) == 0 {
+		if !flagQuiet {
+			fmt.Fprintln(os.Stderr, "No issues found")
+		}
+		return nil
+	} else if !flagQuiet {
+		fmt.Fprintf(os.Stderr, "Found %d issue(s)\n", len(issues))
+	}
+	return WriteIssues(issues, NewOutputConfig(getOutputFormat()))
+}
+
+func runQueryReport(cmd *cobra.Command, args []string) error {
+	cat, params, err := readQueryCatalog()
+	if err != nil {
+		return err
+	}
+	jqls, err := cat.JQLs(params)
+	if err != nil {
+		return err
+	}
+	client, err := NewClientFromOptions(getAuthOptions())
+	if err != nil {
+		return fmt.Errorf("failed to create Jira client: %w", err)
+	}
+	if !flagQuiet {
+		fmt.Fprintf(os.Stderr, "Counting issues for %d queries...\n", len(jqls))
+	}
+	lines, err := client.IssueAPI.JQLsReportMarkdownLines(jqls, &gojira.JQLsReportMarkdownOpts{
+		HeaderPrefix:   queryHeaderPrefix,
+		TimeZone:       queryTimeZone,
+		AddCount:       true,
+		AddExplicitURL: queryExplicitURLs})
+	if err != nil {
+		return fmt.Errorf("report failed: %w", err)
+	}
+	if title := strings.TrimSpace(cat.Title); title != "" {
+		lines = append([]string{"# " + title}, lines...)
+	} else if len(lines) > 0 && lines[0] == "" {
+		lines = lines[1:]
+	}
+	out := strings.Join(lines, "\n") + "\n"
+
+	if queryOutput == "" {
+		fmt.Print(out)
+		return nil
+	} else if err := os.WriteFile(queryOutput, []byte(out), 0600); err != nil {
+		return err
+	} else if !flagQuiet {
+		fmt.Fprintf(os.Stderr, "Wrote %s\n", queryOutput)
+	}
+	return nil
+}
@@ -0,0 +1,15 @@
+package main
+
+import (
+	"github.com/spf13/cobra"
+)
+
+var reportCmd = &cobra.Command{
+	Use:   "report",
+	Short: "Generate flow reports",
+	Long:  `Generate flow reports from issue history, such as cumulative flow diagram data and aging work in progress.`,
+}
+
+func init() {
+	rootCmd.AddCommand(reportCmd)
+}
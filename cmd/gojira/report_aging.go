@@ -0,0 +1,102 @@
+package main
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"strings"
+
+	"github.com/spf13/cobra"
+	toon "github.com/toon-format/toon-go"
+
+	"github.com/grokify/gojira/core"
+)
+
+var reportAgingCmd = &cobra.Command{
+	Use:   "aging",
+	Short: "Aging work-in-progress report",
+	Long: `Show issues that are in progress, oldest first, with their age in the current
+stage and since they first entered progress. Ages are compared to the cycle-time
+percentiles of completed issues of the same type, and issues older than the
+--percentile cycle time are flagged as outliers.
+
+By default the baseline is issues in the same projects resolved in the last 180
+days. Statuses are mapped to stages using Jira status categories.
+
+Examples:
+  # What is stuck on my team?
+  gojira report aging --jql "project = FOO AND statusCategory = \"In Progress\"" --table
+
+  # Flag anything older than the p95 cycle time, with a custom baseline
+  gojira report aging --jql "project = FOO AND statusCategory = \"In Progress\"" \
+    --history-jql "project = FOO AND resolved >= -90d" --percentile 95
+
+  # Token-optimized output for assistants
+  gojira report aging --jql "assignee in membersOf(\"team-a\") AND statusCategory = \"In Progress\"" --toon`,
+	RunE: runReportAging,
+}
+
+var (
+	reportAgingJQL        string
+	reportAgingHistoryJQL string
+	reportAgingPercentile int
+)
+
+func init() {
+	reportCmd.AddCommand(reportAgingCmd)
+
+	reportAgingCmd.Flags().StringVar(&reportAgingJQL, "jql", "", "JQL query for work to check (required)")
+	reportAgingCmd.Flags().StringVar(&reportAgingHistoryJQL, "history-jql", "", "JQL query for completed issues used as the cycle-time baseline")
+	reportAgingCmd.Flags().IntVar(&reportAgingPercentile, "percentile", 85, "Cycle-time percentile that flags outliers: 50, 85 or 95")
+
+	_ = reportAgingCmd.MarkFlagRequired("jql")
+}
+
+func runReportAging(cmd *cobra.Command, args []string) error {
+	client, err := NewClientFromOptions(getAuthOptions())
+	if err != nil {
+		return fmt.Errorf("authentication failed: %w", err)
+	}
+	if !flagQuiet {
+		fmt.Fprintf(os.Stderr, "Searching issues...\n")
+	}
+	result, err := core.AgingWIP(context.Background(), client, &core.AgingWIPInput{
+		JQL:        reportAgingJQL,
+		HistoryJQL: reportAgingHistoryJQL,
+		Percentile: reportAgingPercentile})
+	if err != nil {
+		return err
+	}
+
+	switch getOutputFormat() {
+	case OutputTable:
+		writeAgingTable(result)
+		return nil
+	case OutputTOON:
+		data, err := toon.Marshal(result)
+		if err != nil {
+			return err
+		}
+		fmt.Println(string(data))
+		return nil
+	default:
+		return outputResult(cmd, result)
+	}
+}
+
+func writeAgingTable(result *core.AgingWIPResult) {
+	fmt.Printf("%d issues in progress, %d older than p%d cycle time (baseline: %d issues)\n\n",
+		result.Total, result.Outliers, result.Percentile, result.Baseline)
+	fmt.Printf("%-12s  %-10s  %-16s  %9s  %9s  %8s  %-5s  %s\n", "KEY", "TYPE", "STAGE", "STAGE AGE", "WIP AGE", fmt.Sprintf("P%d", result.Percentile), "LEVEL", "SUMMARY")
+	fmt.Printf("%s  %s  %s  %s  %s  %s  %s  %s\n", strings.Repeat("-", 12), strings.Repeat("-", 10), strings.Repeat("-", 16),
+		strings.Repeat("-", 9), strings.Repeat("-", 9), strings.Repeat("-", 8), strings.Repeat("-", 5), strings.Repeat("-", 30))
+	for _, item := range result.Items {
+		flag := ""
+		if item.Outlier {
+			flag = "! "
+		}
+		fmt.Printf("%-12s  %-10s  %-16s  %9.1f  %9.1f  %8.1f  %-5s  %s%s\n",
+			truncateString(item.Key, 12), truncateString(item.Type, 10), truncateString(item.Stage, 16),
+			item.StageAgeDays, item.InProgressAgeDays, item.PercentileDays, item.Level, flag, truncateString(item.Summary, 40))
+	}
+}
@@ -0,0 +1,216 @@
+package main
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math"
+	"os"
+	"strings"
+	"time"
+
+	"github.com/grokify/gocharts/v2/data/table"
+	"github.com/spf13/cobra"
+
+	"github.com/grokify/gojira/rest"
+)
+
+var reportBurndownCmd = &cobra.Command{
+	Use:   "burndown",
+	Short: "Sprint burndown and burnup data",
+	Long: `Reconstruct, day by day, the issues and story points in scope, done and
+remaining, and the remaining estimate of open issues. History is read from
+issue changelogs, including scope changes: issues added to or removed from the
+sprint and story point changes.
+
+With --sprint, scope follows the issue Sprint field and the date range defaults
+to the sprint dates. Include issues removed from the sprint in --jql to see
+removals, e.g. with removedAfterSprintStart() on Jira Cloud.
+
+Examples:
+  # Sprint burndown with story points
+  gojira report burndown --jql "sprint = 123" --sprint 123 --points-field "Story Points" --table
+
+  # Burnup of a release over a date range
+  gojira report burndown --jql "fixVersion = 2.0" --from 2026-09-01 --to 2026-10-31 --csv burnup.csv
+
+  # Write days and scope changes to Excel
+  gojira report burndown --jql "sprint = 123" --sprint 123 --xlsx burndown.xlsx`,
+	RunE: runReportBurndown,
+}
+
+var (
+	reportBurndownJQL         string
+	reportBurndownSprint      int
+	reportBurndownFrom        string
+	reportBurndownTo          string
+	reportBurndownPointsField string
+	reportBurndownCSV         string
+	reportBurndownXLSX        string
+)
+
+func init() {
+	reportCmd.AddCommand(reportBurndownCmd)
+
+	reportBurndownCmd.Flags().StringVar(&reportBurndownJQL, "jql", "", "JQL query to search issues (required)")
+	reportBurndownCmd.Flags().IntVar(&reportBurndownSprint, "sprint", 0, "Sprint ID whose scope to follow")
+	reportBurndownCmd.Flags().StringVar(&reportBurndownFrom, "from", "", "Start date YYYY-MM-DD (default: sprint start)")
+	reportBurndownCmd.Flags().StringVar(&reportBurndownTo, "to", "", "End date YYYY-MM-DD (default: sprint end or today)")
+	reportBurndownCmd.Flags().StringVar(&reportBurndownPointsField, "points-field", "", "Story points custom field name or ID, e.g. \"Story Points\" or customfield_10016")
+	reportBurndownCmd.Flags().StringVar(&reportBurndownCSV, "csv", "", "Also write the daily data to a CSV file")
+	reportBurndownCmd.Flags().StringVar(&reportBurndownXLSX, "xlsx", "", "Also write the daily data and scope changes to an XLSX file")
+
+	_ = reportBurndownCmd.MarkFlagRequired("jql")
+}
+
+// BurndownDayResult represents one day of a burndown.
+type BurndownDayResult struct {
+	Date                   string  `json:"date"`
+	ScopeIssues            int     `json:"scopeIssues"`
+	DoneIssues             int     `json:"doneIssues"`
+	ScopePoints            float64 `json:"scopePoints"`
+	DonePoints             float64 `json:"donePoints"`
+	RemainingPoints        float64 `json:"remainingPoints"`
+	RemainingEstimateHours float64 `json:"remainingEstimateHours"`
+}
+
+// BurndownOutput represents the full burndown output.
+type BurndownOutput struct {
+	Start        string              `json:"start"`
+	End          string              `json:"end"`
+	SprintID     int                 `json:"sprintId,omitempty"`
+	PointsField  string              `json:"pointsField,omitempty"`
+	Days         []BurndownDayResult `json:"days"`
+	ScopeChanges []rest.ScopeChange  `json:"scopeChanges"`
+}
+
+func runReportBurndown(cmd *cobra.Command, args []string) error {
+	client, err := NewClientFromOptions(getAuthOptions())
+	if err != nil {
+		return fmt.Errorf("authentication failed: %w", err)
+	}
+	ctx := context.Background()
+
+	opts := rest.BurndownOptions{SprintID: reportBurndownSprint}
+	if opts.Start, err = parseReportDate(reportBurndownFrom, false); err != nil {
+		return fmt.Errorf("invalid --from: %w", err)
+	} else if opts.End, err = parseReportDate(reportBurndownTo, true); err != nil {
+		return fmt.Errorf("invalid --to: %w", err)
+	}
+	if reportBurndownSprint != 0 && (opts.Start.IsZero() || opts.End.IsZero()) {
+		sprint, err := client.SprintAPI.Sprint(ctx, reportBurndownSprint)
+		if err != nil {
+			return fmt.Errorf("failed to get sprint: %w", err)
+		}
+		if opts.Start.IsZero() && sprint.StartDate != nil {
+			opts.Start = *sprint.StartDate
+		}
+		if opts.End.IsZero() {
+			if sprint.CompleteDate != nil {
+				opts.End = *sprint.CompleteDate
+			} else if sprint.EndDate != nil && sprint.EndDate.Before(time.Now()) {
+				opts.End = *sprint.EndDate
+			}
+		}
+	}
+	if opts.Start.IsZero() {
+		return errors.New("set --from or a started --sprint")
+	}
+
+	if pf := strings.TrimSpace(reportBurndownPointsField); pf != "" {
+		var cf rest.CustomField
+		if strings.HasPrefix(strings.ToLower(pf), "customfield_") {
+			cf, err = client.CustomFieldAPI.GetCustomFieldByID(pf)
+		} else {
+			cf, err = client.CustomFieldAPI.GetCustomField(pf)
+		}
+		if err != nil {
+			return fmt.Errorf("invalid --points-field: %w", err)
+		}
+		opts.PointsField, opts.PointsFieldName = cf.ID, cf.Name
+	}
+
+	if !flagQuiet {
+		fmt.Fprintf(os.Stderr, "Searching issues...\n")
+	}
+	fields := []string{"status", "created", "resolutiondate", "timeestimate"}
+	if opts.PointsField != "" {
+		fields = append(fields, opts.PointsField)
+	}
+	set, err := client.IssueAPI.SearchIssuesSet(reportBurndownJQL, rest.SearchOptions{
+		Fields: fields,
+		Expand: []string{rest.ExpandFieldChangelog}})
+	if err != nil {
+		return fmt.Errorf("search failed: %w", err)
+	} else if err := client.IssueAPI.IssuesSetLoadChangelogs(ctx, set); err != nil {
+		return fmt.Errorf("failed to load changelogs: %w", err)
+	}
+	if !flagQuiet {
+		fmt.Fprintf(os.Stderr, "Found %d issues\n", set.Len())
+	}
+	if set.Config == nil || set.Config.StatusConfig == nil {
+		scc, err := client.StatusCategoryConfigFromJiraCategories(ctx, nil)
+		if err != nil {
+			return fmt.Errorf("failed to read status categories: %w", err)
+		}
+		opts.DoneStatuses = scc.StatusesDone()
+	}
+
+	bd, err := set.Burndown(opts)
+	if err != nil {
+		return err
+	}
+	tbl := bd.Table()
+	if reportBurndownCSV != "" {
+		if err := tbl.WriteCSV(reportBurndownCSV); err != nil {
+			return fmt.Errorf("failed to write CSV: %w", err)
+		} else if !flagQuiet {
+			fmt.Fprintf(os.Stderr, "Wrote %s\n", reportBurndownCSV)
+		}
+	}
+	if reportBurndownXLSX != "" {
+		if err := table.WriteXLSX(reportBurndownXLSX, []*table.Table{tbl, bd.ScopeChangesTable()}); err != nil {
+			return fmt.Errorf("failed to write XLSX: %w", err)
+		} else if !flagQuiet {
+			fmt.Fprintf(os.Stderr, "Wrote %s\n", reportBurndownXLSX)
+		}
+	}
+
+	output := BurndownOutput{
+		Start:        bd.Start.Format(time.DateOnly),
+		End:          bd.End.Format(time.DateOnly),
+		SprintID:     bd.SprintID,
+		PointsField:  opts.PointsFieldName,
+		ScopeChanges: bd.ScopeChanges}
+	for _, d := range bd.Days {
+		output.Days = append(output.Days, BurndownDayResult{
+			Date:                   d.Date.Format(time.DateOnly),
+			ScopeIssues:            d.ScopeIssues,
+			DoneIssues:             d.DoneIssues,
+			ScopePoints:            d.ScopePoints,
+			DonePoints:             d.DonePoints,
+			RemainingPoints:        d.RemainingPoints,
+			RemainingEstimateHours: math.Round(d.RemainingEstimate.Hours()*100) / 100})
+	}
+	if getOutputFormat() == OutputTable {
+		writeBurndownTable(output)
+		return nil
+	}
+	return outputResult(cmd, output)
+}
+
+func writeBurndownTable(output BurndownOutput) {
+	fmt.Printf("%-10s  %6s  %6s  %8s  %8s  %9s  %9s\n", "DATE", "SCOPE", "DONE", "SCOPE PT", "DONE PT", "REMAIN PT", "REMAIN HR")
+	fmt.Printf("%s  %s  %s  %s  %s  %s  %s\n", strings.Repeat("-", 10), strings.Repeat("-", 6), strings.Repeat("-", 6),
+		strings.Repeat("-", 8), strings.Repeat("-", 8), strings.Repeat("-", 9), strings.Repeat("-", 9))
+	for _, d := range output.Days {
+		fmt.Printf("%-10s  %6d  %6d  %8.1f  %8.1f  %9.1f  %9.1f\n",
+			d.Date, d.ScopeIssues, d.DoneIssues, d.ScopePoints, d.DonePoints, d.RemainingPoints, d.RemainingEstimateHours)
+	}
+	if len(output.ScopeChanges) > 0 {
+		fmt.Printf("\nScope changes:\n\n")
+		for _, sc := range output.ScopeChanges {
+			fmt.Printf("%s  %-12s  %-8s  %+.1f\n", sc.Time.Format("2006-01-02 15:04"), sc.Key, sc.Type, sc.Points)
+		}
+	}
+}
@@ -0,0 +1,196 @@
+package main
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"strings"
+	"time"
+
+	"github.com/grokify/gocharts/v2/data/table"
+	"github.com/grokify/mogo/time/timeutil"
+	"github.com/spf13/cobra"
+
+	"github.com/grokify/gojira/rest"
+)
+
+var reportCFDCmd = &cobra.Command{
+	Use:   "cfd",
+	Short: "Cumulative flow diagram data",
+	Long: `Show the number of issues in each status for each day or week, for use in a
+cumulative flow diagram. Status history is read from issue changelogs.
+
+With --meta-stage, statuses are grouped into meta stages using Jira status
+categories: To Do statuses into "Ready for Development", In Progress statuses
+into "In Development" and Done statuses into "Done".
+
+Examples:
+  # Daily counts by status for the last 30 days
+  gojira report cfd --jql "project = FOO" --from 2026-01-01 --to 2026-01-31 --table
+
+  # Weekly counts by meta stage
+  gojira report cfd --jql "project = FOO" --interval week --meta-stage
+
+  # Write CSV and XLSX for charting
+  gojira report cfd --jql "project = FOO" --csv cfd.csv --xlsx cfd.xlsx`,
+	RunE: runReportCFD,
+}
+
+var (
+	reportCFDJQL       string
+	reportCFDFrom      string
+	reportCFDTo        string
+	reportCFDInterval  string
+	reportCFDMetaStage bool
+	reportCFDCSV       string
+	reportCFDXLSX      string
+)
+
+func init() {
+	reportCmd.AddCommand(reportCFDCmd)
+
+	reportCFDCmd.Flags().StringVar(&reportCFDJQL, "jql", "", "JQL query to search issues (required)")
+	reportCFDCmd.Flags().StringVar(&reportCFDFrom, "from", "", "Start date YYYY-MM-DD (default: earliest issue creation date)")
+	reportCFDCmd.Flags().StringVar(&reportCFDTo, "to", "", "End date YYYY-MM-DD (default: today)")
+	reportCFDCmd.Flags().StringVar(&reportCFDInterval, "interval", "day", "Interval: day or week")
+	reportCFDCmd.Flags().BoolVar(&reportCFDMetaStage, "meta-stage", false, "Group statuses into meta stages")
+	reportCFDCmd.Flags().StringVar(&reportCFDCSV, "csv", "", "Also write the data to a CSV file")
+	reportCFDCmd.Flags().StringVar(&reportCFDXLSX, "xlsx", "", "Also write the data to an XLSX file")
+
+	_ = reportCFDCmd.MarkFlagRequired("jql")
+}
+
+// CFDRow represents the issue counts for one interval.
+type CFDRow struct {
+	Date   string           `json:"date"`
+	Counts map[string]int64 `json:"counts"`
+}
+
+// CFDOutput represents the full cumulative flow output.
+type CFDOutput struct {
+	Interval string   `json:"interval"`
+	Series   []string `json:"series"`
+	Total    int      `json:"total"`
+	Rows     []CFDRow `json:"rows"`
+}
+
+func runReportCFD(cmd *cobra.Command, args []string) error {
+	opts := &rest.CFDOptions{UseMetaStage: reportCFDMetaStage}
+	switch strings.ToLower(strings.TrimSpace(reportCFDInterval)) {
+	case "", "day", "daily":
+		opts.Interval = timeutil.IntervalDay
+	case "week", "weekly":
+		opts.Interval = timeutil.IntervalWeek
+	default:
+		return fmt.Errorf("invalid --interval %q: use day or week", reportCFDInterval)
+	}
+	var err error
+	if opts.Start, err = parseReportDate(reportCFDFrom, false); err != nil {
+		return fmt.Errorf("invalid --from: %w", err)
+	} else if opts.End, err = parseReportDate(reportCFDTo, true); err != nil {
+		return fmt.Errorf("invalid --to: %w", err)
+	}
+
+	client, err := NewClientFromOptions(getAuthOptions())
+	if err != nil {
+		return fmt.Errorf("authentication failed: %w", err)
+	}
+	ctx := context.Background()
+
+	if !flagQuiet {
+		fmt.Fprintf(os.Stderr, "Searching issues...\n")
+	}
+	set, err := client.IssueAPI.SearchIssuesSet(reportCFDJQL, rest.SearchOptions{
+		Fields: []string{"issuetype", "project", "status", "created"},
+		Expand: []string{rest.ExpandFieldChangelog}})
+	if err != nil {
+		return fmt.Errorf("search failed: %w", err)
+	} else if err := client.IssueAPI.IssuesSetLoadChangelogs(ctx, set); err != nil {
+		return fmt.Errorf("failed to load changelogs: %w", err)
+	}
+	if !flagQuiet {
+		fmt.Fprintf(os.Stderr, "Found %d issues\n", set.Len())
+	}
+	if reportCFDMetaStage && (set.Config == nil || set.Config.StatusConfig == nil) {
+		scc, err := client.StatusCategoryConfigFromJiraCategories(ctx, nil)
+		if err != nil {
+			return fmt.Errorf("failed to read status categories: %w", err)
+		}
+		set.Config.StatusConfig = scc
+	}
+
+	tss, err := set.TimeSeriesSetCFD(opts)
+	if err != nil {
+		return err
+	}
+	tbl := rest.CFDTable(tss)
+	if reportCFDCSV != "" {
+		if err := tbl.WriteCSV(reportCFDCSV); err != nil {
+			return fmt.Errorf("failed to write CSV: %w", err)
+		} else if !flagQuiet {
+			fmt.Fprintf(os.Stderr, "Wrote %s\n", reportCFDCSV)
+		}
+	}
+	if reportCFDXLSX != "" {
+		tbl.Name = "cfd"
+		if err := table.WriteXLSX(reportCFDXLSX, []*table.Table{tbl}); err != nil {
+			return fmt.Errorf("failed to write XLSX: %w", err)
+		} else if !flagQuiet {
+			fmt.Fprintf(os.Stderr, "Wrote %s\n", reportCFDXLSX)
+		}
+	}
+
+	output := CFDOutput{
+		Interval: strings.ToLower(tss.Interval.String()),
+		Series:   tss.Order,
+		Total:    set.Len()}
+	for _, dt := range tss.Times {
+		row := CFDRow{Date: dt.Format(time.DateOnly), Counts: map[string]int64{}}
+		for _, name := range tss.Order {
+			row.Counts[name] = tss.GetInt64WithDefault(name, dt.Format(time.RFC3339), 0)
+		}
+		output.Rows = append(output.Rows, row)
+	}
+	if getOutputFormat() == OutputTable {
+		return writeCFDTable(tbl)
+	}
+	return outputResult(cmd, output)
+}
+
+// parseReportDate parses a YYYY-MM-DD date in UTC. With endOfDay, the last instant of the day is returned.
+func parseReportDate(s string, endOfDay bool) (time.Time, error) {
+	if s = strings.TrimSpace(s); s == "" {
+		return time.Time{}, nil
+	}
+	t, err := time.Parse(time.DateOnly, s)
+	if err != nil {
+		return t, err
+	} else if endOfDay {
+		t = t.AddDate(0, 0, 1).Add(-time.Nanosecond)
+	}
+	return t, nil
+}
+
+func writeCFDTable(tbl *table.Table) error {
+	widths := make([]int, len(tbl.Columns))
+	for i, col := range tbl.Columns {
+		widths[i] = max(len(col), 10)
+	}
+	var sb strings.Builder
+	for i, col := range tbl.Columns {
+		fmt.Fprintf(&sb, "%-*s  ", widths[i], col)
+	}
+	fmt.Println(strings.TrimRight(sb.String(), " "))
+	for _, row := range tbl.Rows {
+		sb.Reset()
+		for i, cell := range row {
+			if i == 0 {
+				fmt.Fprintf(&sb, "%-*s  ", widths[i], cell)
+			} else {
+				fmt.Fprintf(&sb, "%*s  ", widths[i], cell)
+			}
+		}
+		fmt.Println(strings.TrimRight(sb.String(), " "))
+	}
+	return nil
+}
@@ -1,19 +1,25 @@
 package main
 
 import (
+	"fmt"
 	"os"
 
+	"github.com/grokify/gojira/rest"
 	"github.com/spf13/cobra"
 )
 
 var (
 	// Global flags
-	flagJSON      bool
-	flagTable     bool
-	flagTOON      bool
-	flagCredsFile string
-	flagAccount   string
-	flagQuiet     bool
+	flagJSON         bool
+	flagTable        bool
+	flagTOON         bool
+	flagCredsFile    string
+	flagAccount      string
+	flagQuiet        bool
+	flagRateLimit    float64
+	flagRateBurst    int
+	flagRateAdaptive bool
+	flagWorkflow     string
 )
 
 // rootCmd represents the base command when called without any subcommands.
@@ -28,8 +34,12 @@ for LLMs.
 
 Authentication:
   1. CLI flags: --creds-file, --account
-  2. Environment variables: JIRA_URL, JIRA_USER, JIRA_TOKEN
-  3. Default goauth file: ~/.config/goauth/credentials.json
+  2. Environment variables: JIRA_URL with JIRA_PAT (bearer token), or
+     JIRA_URL, JIRA_USER, JIRA_TOKEN (basic auth)
+  3. OAuth 2.0 token from 'gojira auth login': JIRA_OAUTH_CLIENT_ID,
+     JIRA_OAUTH_CLIENT_SECRET
+  4. Default goauth file: ~/.config/goauth/credentials.json (interactive
+     account selection)
 
 Examples:
   # Search with JQL
@@ -48,10 +58,24 @@ Examples:
 // Execute adds all child commands to the root command and sets flags appropriately.
 func Execute() {
 	if err := rootCmd.Execute(); err != nil {
+		printFieldErrors(err)
 		os.Exit(1)
 	}
 }
 
+// printFieldErrors lists the fields Jira rejected, one per line, after the error
+// printed by cobra.
+func printFieldErrors(err error) {
+	apiErr, ok := rest.AsAPIError(err)
+	if !ok || len(apiErr.Errors) == 0 {
+		return
+	}
+	fmt.Fprintln(os.Stderr, "Field errors:")
+	for _, fe := range apiErr.FieldErrorStrings() {
+		fmt.Fprintf(os.Stderr, "  %s\n", fe)
+	}
+}
+
 func init() {
 	// Output format flags (mutually exclusive, JSON is default)
 	rootCmd.PersistentFlags().BoolVarP(&flagJSON, "json", "j", false, "Output as JSON (default)")
@@ -62,6 +86,14 @@ func init() {
 	rootCmd.PersistentFlags().StringVar(&flagCredsFile, "creds-file", "", "Path to goauth credentials file")
 	rootCmd.PersistentFlags().StringVar(&flagAccount, "account", "", "Account key in credentials file")
 
+	// Rate limiting flags
+	rootCmd.PersistentFlags().Float64Var(&flagRateLimit, "rate-limit", 0, "Maximum Jira API requests per second (0 = unlimited)")
+	rootCmd.PersistentFlags().IntVar(&flagRateBurst, "rate-burst", 1, "Burst size for --rate-limit")
+	rootCmd.PersistentFlags().BoolVar(&flagRateAdaptive, "rate-adaptive", false, "Slow down --rate-limit when Jira returns rate-limit headers")
+
+	// Workflow flags
+	rootCmd.PersistentFlags().StringVar(&flagWorkflow, "workflow", "", "Path to a workflow YAML file mapping statuses to stages (see 'gojira workflow')")
+
 	// Other flags
 	rootCmd.PersistentFlags().BoolVarP(&flagQuiet, "quiet", "q", false, "Suppress non-essential output")
 
@@ -84,7 +116,11 @@ func getOutputFormat() OutputFormat {
 // getAuthOptions returns authentication options from global flags.
 func getAuthOptions() *AuthOptions {
 	return &AuthOptions{
-		CredsFile: flagCredsFile,
-		Account:   flagAccount,
+		CredsFile:    flagCredsFile,
+		Account:      flagAccount,
+		RateLimit:    flagRateLimit,
+		RateBurst:    flagRateBurst,
+		RateAdaptive: flagRateAdaptive,
+		Workflow:     flagWorkflow,
 	}
 }
@@ -1,9 +1,14 @@
 package main
 
 import (
+	"context"
+	"encoding/json"
 	"fmt"
 	"os"
 
+	jira "github.com/andygrunwald/go-jira"
+	"github.com/grokify/gojira"
+	"github.com/grokify/gojira/rest"
 	"github.com/spf13/cobra"
 )
 
@@ -12,6 +17,13 @@ var (
 	flagSearchMax    int
 	flagSearchAll    bool
 	flagSearchFields string
+	flagSearchExpand string
+	flagSearchNDJSON bool
+
+	flagSearchFromJSON    string
+	flagSearchFieldsJSON  string
+	flagSearchCurrentUser string
+	flagSearchLint        bool
 )
 
 var searchCmd = &cobra.Command{
@@ -33,7 +45,19 @@ Examples:
   gojira search --jql "assignee = currentUser()" --table
 
   # Token-optimized output for LLMs
-  gojira search --jql "project = FOO" --toon`,
+  gojira search --jql "project = FOO" --toon
+
+  # Stream all results as newline-delimited JSON
+  gojira search --jql "project = FOO" --all --ndjson > issues.ndjson
+
+  # Request only some fields, with changelog expansion
+  gojira search --jql "project = FOO" --fields key,summary,status --expand changelog
+
+  # Check the query for unknown fields and values before searching
+  gojira search --jql "project = FOO AND status = 'In Progress'" --lint
+
+  # Query an exported file offline
+  gojira search --from-json issues.json --jql "status = 'In Progress' AND labels = ux" --table`,
 	RunE: runSearch,
 }
 
@@ -43,7 +67,13 @@ func init() {
 	searchCmd.Flags().StringVar(&flagSearchJQL, "jql", "", "JQL query string (required)")
 	searchCmd.Flags().IntVarP(&flagSearchMax, "max", "m", 50, "Maximum number of results")
 	searchCmd.Flags().BoolVarP(&flagSearchAll, "all", "a", false, "Retrieve all results (paginate automatically)")
-	searchCmd.Flags().StringVarP(&flagSearchFields, "fields", "f", "", "Comma-separated list of fields to include")
+	searchCmd.Flags().StringVarP(&flagSearchFields, "fields", "f", "", "Comma-separated list of fields to request (default all fields)")
+	searchCmd.Flags().StringVar(&flagSearchExpand, "expand", "", "Comma-separated list of expansions, e.g. changelog,renderedFields")
+	searchCmd.Flags().BoolVar(&flagSearchNDJSON, "ndjson", false, "Stream results as newline-delimited JSON, one issue per line")
+	searchCmd.Flags().StringVar(&flagSearchFromJSON, "from-json", "", "Evaluate the JQL locally against issues from a JSON file written by 'gojira export --json'")
+	searchCmd.Flags().StringVar(&flagSearchFieldsJSON, "fields-json", "", "Custom field definitions from 'gojira fields --json', for custom field names with --from-json")
+	searchCmd.Flags().BoolVar(&flagSearchLint, "lint", false, "Check the JQL with 'gojira jql lint' first and stop if it has errors")
+	searchCmd.Flags().StringVar(&flagSearchCurrentUser, "current-user", "", "Account ID, name or email matched by currentUser() with --from-json")
 
 	if err := searchCmd.MarkFlagRequired("jql"); err != nil {
 		panic(err)
@@ -53,6 +83,8 @@ func init() {
 func runSearch(cmd *cobra.Command, args []string) error {
 	if flagSearchJQL == "" {
 		return fmt.Errorf("--jql flag is required")
+	} else if flagSearchFromJSON != "" {
+		return runSearchFromJSON()
 	}
 
 	// Create client
@@ -61,15 +93,33 @@ func runSearch(cmd *cobra.Command, args []string) error {
 		return fmt.Errorf("failed to create Jira client: %w", err)
 	}
 
-	// Search issues
-	var issues, errSearch = client.IssueAPI.SearchIssues(flagSearchJQL, flagSearchAll || flagSearchMax == 0)
-	if errSearch != nil {
-		return fmt.Errorf("search failed: %w", errSearch)
+	if flagSearchLint {
+		if err := lintSearchJQL(context.Background(), client, flagSearchJQL); err != nil {
+			return err
+		}
+	}
+
+	// Search issues, requesting pages only until the max limit is reached
+	limit := flagSearchMax
+	if flagSearchAll {
+		limit = 0
+	}
+	seq := client.IssueAPI.SearchIssuesSeq(context.Background(), flagSearchJQL,
+		searchOptionsFromFlags(flagSearchFields, flagSearchExpand))
+
+	if flagSearchNDJSON {
+		count, err := writeIssuesNDJSON(os.Stdout, seq, limit, func(iss *jira.Issue) any { return rest.ToIssueOutput(iss) })
+		if err != nil {
+			return fmt.Errorf("search failed: %w", err)
+		} else if !flagQuiet {
+			fmt.Fprintf(os.Stderr, "Found %d issue(s)\n", count)
+		}
+		return nil
 	}
 
-	// Apply max limit if not retrieving all
-	if !flagSearchAll && flagSearchMax > 0 && len(issues) > flagSearchMax {
-		issues = issues[:flagSearchMax]
+	issues, errSearch := collectIssuesMax(seq, limit)
+	if errSearch != nil {
+		return fmt.Errorf("search failed: %w", errSearch)
 	}
 
 	if len(issues) == 0 {
@@ -87,3 +137,70 @@ func runSearch(cmd *cobra.Command, args []string) error {
 	cfg := NewOutputConfig(getOutputFormat())
 	return WriteIssues(issues, cfg)
 }
+
+// runSearchFromJSON evaluates `--jql` against the issues in the `--from-json` file, without a client.
+func runSearchFromJSON() error {
+	if flagSearchNDJSON {
+		return fmt.Errorf("--ndjson cannot be combined with --from-json")
+	}
+	set, err := rest.IssuesSetReadFileJSON(flagSearchFromJSON)
+	if err != nil {
+		return fmt.Errorf("failed to read JSON file: %w", err)
+	}
+	opts := &rest.JQLEvalOptions{CurrentUser: flagSearchCurrentUser}
+	if flagSearchFieldsJSON != "" {
+		if opts.CustomFieldSet, err = readCustomFieldSetJSON(flagSearchFieldsJSON); err != nil {
+			return fmt.Errorf("failed to read fields JSON file: %w", err)
+		}
+	}
+	out, err := set.FilterJQL(flagSearchJQL, opts)
+	if err != nil {
+		return err
+	}
+	q, err := gojira.ParseJQL(flagSearchJQL)
+	if err != nil {
+		return err
+	}
+	keys, err := out.KeysOrderJQL(q.OrderBy, opts)
+	if err != nil {
+		return fmt.Errorf("ORDER BY cannot be evaluated locally: %w", err)
+	}
+	var issues []jira.Issue
+	for _, key := range keys {
+		if !flagSearchAll && flagSearchMax > 0 && len(issues) >= flagSearchMax {
+			break
+		}
+		issues = append(issues, out.Items[key])
+	}
+	if len(issues) == 0 {
+		if !flagQuiet {
+			fmt.Fprintln(os.Stderr, "No issues found")
+		}
+		return nil
+	} else if !flagQuiet {
+		fmt.Fprintf(os.Stderr, "Found %d of %d issue(s)\n", len(issues), set.Len())
+	}
+	return WriteIssues(issues, NewOutputConfig(getOutputFormat()))
+}
+
+// readCustomFieldSetJSON reads the custom fields written by `gojira fields --json`.
+func readCustomFieldSetJSON(filename string) (*rest.CustomFieldSet, error) {
+	b, err := os.ReadFile(filename)
+	if err != nil {
+		return nil, err
+	}
+	var cfs rest.CustomFields
+	if err := json.Unmarshal(b, &cfs); err != nil {
+		return nil, err
+	}
+	set := rest.NewCustomFieldSet()
+	return set, set.Add(cfs...)
+}
+
+// searchOptionsFromFlags builds `rest.SearchOptions` from comma-separated
+// `--fields` and `--expand` flag values.
+func searchOptionsFromFlags(fields, expand string) rest.SearchOptions {
+	return rest.SearchOptions{
+		Fields: rest.ParseFieldsCSV(fields),
+		Expand: rest.ParseFieldsCSV(expand)}
+}
@@ -0,0 +1,305 @@
+package main
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"os"
+	"strings"
+	"time"
+
+	jira "github.com/andygrunwald/go-jira"
+	"github.com/spf13/cobra"
+
+	"github.com/grokify/gojira/rest"
+)
+
+var sprintCmd = &cobra.Command{
+	Use:   "sprint",
+	Short: "Manage sprints and sprint issues",
+	Long: `Manage Jira Software sprints using the Agile API.
+
+Examples:
+  # Show a sprint and its issues
+  gojira sprint get 123
+  gojira sprint issues 123 --table
+
+  # Plan a sprint
+  gojira sprint create --board 42 --name "Sprint 24" --goal "Checkout v2"
+  gojira sprint move 124 FOO-1 FOO-2 FOO-3
+  gojira sprint rank FOO-3 --before FOO-1
+
+  # Run it
+  gojira sprint start 124 --start 2026-10-19 --end 2026-11-01
+  gojira sprint backlog FOO-2
+  gojira sprint close 124`,
+}
+
+var sprintGetCmd = &cobra.Command{
+	Use:   "get <sprint-id>",
+	Short: "Get a sprint",
+	Args:  cobra.ExactArgs(1),
+	RunE:  runSprintGet,
+}
+
+var sprintIssuesCmd = &cobra.Command{
+	Use:   "issues <sprint-id>",
+	Short: "List the issues in a sprint",
+	Args:  cobra.ExactArgs(1),
+	RunE:  runSprintIssues,
+}
+
+var sprintMoveCmd = &cobra.Command{
+	Use:   "move <sprint-id> <issue-key>...",
+	Short: "Move issues to a sprint",
+	Args:  cobra.MinimumNArgs(2),
+	RunE:  runSprintMove,
+}
+
+var sprintBacklogCmd = &cobra.Command{
+	Use:   "backlog <issue-key>...",
+	Short: "Move issues to the backlog",
+	Args:  cobra.MinimumNArgs(1),
+	RunE:  runSprintBacklog,
+}
+
+var sprintCreateCmd = &cobra.Command{
+	Use:   "create",
+	Short: "Create a sprint",
+	Args:  cobra.NoArgs,
+	RunE:  runSprintCreate,
+}
+
+var sprintStartCmd = &cobra.Command{
+	Use:   "start <sprint-id>",
+	Short: "Start a sprint",
+	Args:  cobra.ExactArgs(1),
+	RunE:  runSprintStart,
+}
+
+var sprintCloseCmd = &cobra.Command{
+	Use:   "close <sprint-id>",
+	Short: "Close a sprint",
+	Args:  cobra.ExactArgs(1),
+	RunE:  runSprintClose,
+}
+
+var sprintRankCmd = &cobra.Command{
+	Use:   "rank <issue-key>...",
+	Short: "Rank issues before or after another issue",
+	Args:  cobra.MinimumNArgs(1),
+	RunE:  runSprintRank,
+}
+
+var (
+	sprintFields  string
+	sprintBoardID int
+	sprintName    string
+	sprintGoal    string
+	sprintStart   string
+	sprintEnd     string
+	sprintBefore  string
+	sprintAfter   string
+)
+
+func init() {
+	rootCmd.AddCommand(sprintCmd)
+	sprintCmd.AddCommand(sprintGetCmd, sprintIssuesCmd, sprintMoveCmd, sprintBacklogCmd,
+		sprintCreateCmd, sprintStartCmd, sprintCloseCmd, sprintRankCmd)
+
+	sprintIssuesCmd.Flags().StringVarP(&sprintFields, "fields", "f", "", "Comma-separated fields to request (default: all)")
+
+	sprintCreateCmd.Flags().IntVar(&sprintBoardID, "board", 0, "Board ID (required)")
+	sprintCreateCmd.Flags().StringVar(&sprintName, "name", "", "Sprint name (required)")
+	sprintCreateCmd.Flags().StringVar(&sprintGoal, "goal", "", "Sprint goal")
+	sprintCreateCmd.Flags().StringVar(&sprintStart, "start", "", "Start date YYYY-MM-DD")
+	sprintCreateCmd.Flags().StringVar(&sprintEnd, "end", "", "End date YYYY-MM-DD")
+	_ = sprintCreateCmd.MarkFlagRequired("board")
+	_ = sprintCreateCmd.MarkFlagRequired("name")
+
+	sprintStartCmd.Flags().StringVar(&sprintStart, "start", "", "Start date YYYY-MM-DD (default: the sprint's start date or today)")
+	sprintStartCmd.Flags().StringVar(&sprintEnd, "end", "", "End date YYYY-MM-DD (default: the sprint's end date or two weeks after start)")
+
+	sprintRankCmd.Flags().StringVar(&sprintBefore, "before", "", "Rank the issues before this issue")
+	sprintRankCmd.Flags().StringVar(&sprintAfter, "after", "", "Rank the issues after this issue")
+}
+
+func runSprintGet(cmd *cobra.Command, args []string) error {
+	sprintID, err := parseAgileID(args[0], "sprint")
+	if err != nil {
+		return err
+	}
+	client, err := NewClientFromOptions(getAuthOptions())
+	if err != nil {
+		return fmt.Errorf("authentication failed: %w", err)
+	}
+	sprint, err := client.SprintAPI.Sprint(context.Background(), sprintID)
+	if err != nil {
+		return fmt.Errorf("get sprint failed: %w", err)
+	}
+	return writeSprint(cmd, sprint)
+}
+
+func runSprintIssues(cmd *cobra.Command, args []string) error {
+	sprintID, err := parseAgileID(args[0], "sprint")
+	if err != nil {
+		return err
+	}
+	client, err := NewClientFromOptions(getAuthOptions())
+	if err != nil {
+		return fmt.Errorf("authentication failed: %w", err)
+	}
+	set, err := client.SprintAPI.IssuesSet(context.Background(), sprintID, rest.SearchOptions{Fields: rest.ParseFieldsCSV(sprintFields)})
+	if err != nil {
+		return fmt.Errorf("get sprint issues failed: %w", err)
+	}
+	return WriteIssues(set.Issues(), NewOutputConfig(getOutputFormat()))
+}
+
+func runSprintMove(cmd *cobra.Command, args []string) error {
+	sprintID, err := parseAgileID(args[0], "sprint")
+	if err != nil {
+		return err
+	}
+	client, err := NewClientFromOptions(getAuthOptions())
+	if err != nil {
+		return fmt.Errorf("authentication failed: %w", err)
+	}
+	if err := client.SprintAPI.MoveIssues(context.Background(), sprintID, args[1:]...); err != nil {
+		return fmt.Errorf("move issues failed: %w", err)
+	}
+	return outputResult(cmd, map[string]any{"success": true, "sprintId": sprintID, "issues": args[1:]})
+}
+
+func runSprintBacklog(cmd *cobra.Command, args []string) error {
+	client, err := NewClientFromOptions(getAuthOptions())
+	if err != nil {
+		return fmt.Errorf("authentication failed: %w", err)
+	}
+	if err := client.SprintAPI.MoveIssuesToBacklog(context.Background(), args...); err != nil {
+		return fmt.Errorf("move issues to backlog failed: %w", err)
+	}
+	return outputResult(cmd, map[string]any{"success": true, "issues": args})
+}
+
+func runSprintCreate(cmd *cobra.Command, args []string) error {
+	req := rest.SprintCreateRequest{Name: sprintName, OriginBoardID: sprintBoardID, Goal: sprintGoal}
+	if start, err := parseReportDate(sprintStart, false); err != nil {
+		return fmt.Errorf("invalid --start: %w", err)
+	} else if !start.IsZero() {
+		req.StartDate = &start
+	}
+	if end, err := parseReportDate(sprintEnd, false); err != nil {
+		return fmt.Errorf("invalid --end: %w", err)
+	} else if !end.IsZero() {
+		req.EndDate = &end
+	}
+	client, err := NewClientFromOptions(getAuthOptions())
+	if err != nil {
+		return fmt.Errorf("authentication failed: %w", err)
+	}
+	sprint, err := client.SprintAPI.Create(context.Background(), req)
+	if err != nil {
+		return fmt.Errorf("create sprint failed: %w", err)
+	}
+	return writeSprint(cmd, sprint)
+}
+
+func runSprintStart(cmd *cobra.Command, args []string) error {
+	sprintID, err := parseAgileID(args[0], "sprint")
+	if err != nil {
+		return err
+	}
+	start, err := parseReportDate(sprintStart, false)
+	if err != nil {
+		return fmt.Errorf("invalid --start: %w", err)
+	}
+	end, err := parseReportDate(sprintEnd, false)
+	if err != nil {
+		return fmt.Errorf("invalid --end: %w", err)
+	}
+	client, err := NewClientFromOptions(getAuthOptions())
+	if err != nil {
+		return fmt.Errorf("authentication failed: %w", err)
+	}
+	ctx := context.Background()
+	if start.IsZero() || end.IsZero() {
+		current, err := client.SprintAPI.Sprint(ctx, sprintID)
+		if err != nil {
+			return fmt.Errorf("get sprint failed: %w", err)
+		}
+		if start.IsZero() {
+			if current.StartDate != nil {
+				start = *current.StartDate
+			} else {
+				start = time.Now().UTC()
+			}
+		}
+		if end.IsZero() {
+			if current.EndDate != nil && current.EndDate.After(start) {
+				end = *current.EndDate
+			} else {
+				end = start.AddDate(0, 0, 14)
+			}
+		}
+	}
+	sprint, err := client.SprintAPI.Start(ctx, sprintID, start, end)
+	if err != nil {
+		return fmt.Errorf("start sprint failed: %w", err)
+	}
+	return writeSprint(cmd, sprint)
+}
+
+func runSprintClose(cmd *cobra.Command, args []string) error {
+	sprintID, err := parseAgileID(args[0], "sprint")
+	if err != nil {
+		return err
+	}
+	client, err := NewClientFromOptions(getAuthOptions())
+	if err != nil {
+		return fmt.Errorf("authentication failed: %w", err)
+	}
+	sprint, err := client.SprintAPI.Close(context.Background(), sprintID)
+	if err != nil {
+		return fmt.Errorf("close sprint failed: %w", err)
+	}
+	return writeSprint(cmd, sprint)
+}
+
+func runSprintRank(cmd *cobra.Command, args []string) error {
+	if (sprintBefore == "") == (sprintAfter == "") {
+		return errors.New("set one of --before or --after")
+	}
+	client, err := NewClientFromOptions(getAuthOptions())
+	if err != nil {
+		return fmt.Errorf("authentication failed: %w", err)
+	}
+	if err := client.IssueAPI.RankIssues(context.Background(), args, sprintBefore, sprintAfter); err != nil {
+		return fmt.Errorf("rank issues failed: %w", err)
+	}
+	return outputResult(cmd, map[string]any{"success": true, "issues": args})
+}
+
+func writeSprint(cmd *cobra.Command, sprint *jira.Sprint) error {
+	if getOutputFormat() == OutputTable {
+		writeSprintsTable([]jira.Sprint{*sprint})
+		return nil
+	}
+	return outputResult(cmd, sprint)
+}
+
+func writeSprintsTable(sprints []jira.Sprint) {
+	date := func(t *time.Time) string {
+		if t == nil {
+			return ""
+		}
+		return t.Format(time.DateOnly)
+	}
+	fmt.Fprintf(os.Stdout, "%8s  %-7s  %-10s  %-10s  %-10s  %s\n", "ID", "STATE", "START", "END", "COMPLETE", "NAME")
+	fmt.Fprintf(os.Stdout, "%s  %s  %s  %s  %s  %s\n", strings.Repeat("-", 8), strings.Repeat("-", 7),
+		strings.Repeat("-", 10), strings.Repeat("-", 10), strings.Repeat("-", 10), strings.Repeat("-", 30))
+	for _, s := range sprints {
+		fmt.Fprintf(os.Stdout, "%8d  %-7s  %-10s  %-10s  %-10s  %s\n",
+			s.ID, s.State, date(s.StartDate), date(s.EndDate), date(s.CompleteDate), s.Name)
+	}
+}
@@ -0,0 +1,114 @@
+package main
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"strings"
+	"time"
+
+	"github.com/grokify/gocharts/v2/data/table"
+	"github.com/spf13/cobra"
+
+	"github.com/grokify/gojira/rest"
+)
+
+var sprintVelocityCmd = &cobra.Command{
+	Use:   "velocity",
+	Short: "Show committed vs completed work for a board's recent sprints",
+	Long: `Show the velocity of a board's most recent closed sprints.
+
+For each sprint: committed issues and points at sprint start, scope added and
+removed during the sprint, completed work, carry-over and a rolling average of
+completed work. History is read from issue changelogs.
+
+Examples:
+  # Last 6 sprints as a table
+  gojira sprint velocity --board 42 --table
+
+  # Last 10 sprints, 4-sprint average, with a Markdown file for a wiki
+  gojira sprint velocity --board 42 --sprints 10 --window 4 --markdown velocity.md
+
+  # Team-managed projects name the field differently
+  gojira sprint velocity --board 42 --points-field "Story point estimate"`,
+	Args: cobra.NoArgs,
+	RunE: runSprintVelocity,
+}
+
+var (
+	sprintVelocityBoard       int
+	sprintVelocitySprints     int
+	sprintVelocityWindow      int
+	sprintVelocityPointsField string
+	sprintVelocityXLSX        string
+	sprintVelocityMarkdown    string
+)
+
+func init() {
+	sprintCmd.AddCommand(sprintVelocityCmd)
+	sprintVelocityCmd.Flags().IntVar(&sprintVelocityBoard, "board", 0, "Board ID (required)")
+	sprintVelocityCmd.Flags().IntVar(&sprintVelocitySprints, "sprints", rest.VelocitySprintsDefault, "Number of most recent closed sprints")
+	sprintVelocityCmd.Flags().IntVar(&sprintVelocityWindow, "window", rest.VelocityWindowDefault, "Sprints in the rolling average")
+	sprintVelocityCmd.Flags().StringVar(&sprintVelocityPointsField, "points-field", rest.CustomFieldNameStoryPoints, "Story points custom field name or ID")
+	sprintVelocityCmd.Flags().StringVar(&sprintVelocityXLSX, "xlsx", "", "Also write the report to an XLSX file")
+	sprintVelocityCmd.Flags().StringVar(&sprintVelocityMarkdown, "markdown", "", "Also write the report to a Markdown file")
+	_ = sprintVelocityCmd.MarkFlagRequired("board")
+}
+
+func runSprintVelocity(cmd *cobra.Command, args []string) error {
+	client, err := NewClientFromOptions(getAuthOptions())
+	if err != nil {
+		return fmt.Errorf("authentication failed: %w", err)
+	}
+	if !flagQuiet {
+		fmt.Fprintf(os.Stderr, "Reading sprints...\n")
+	}
+	r, err := client.BoardAPI.Velocity(context.Background(), sprintVelocityBoard, rest.VelocityOptions{
+		Sprints:     sprintVelocitySprints,
+		Window:      sprintVelocityWindow,
+		PointsField: sprintVelocityPointsField})
+	if err != nil {
+		return fmt.Errorf("velocity failed: %w", err)
+	}
+
+	tbl := r.Table()
+	if sprintVelocityXLSX != "" {
+		if err := table.WriteXLSX(sprintVelocityXLSX, []*table.Table{tbl}); err != nil {
+			return fmt.Errorf("failed to write XLSX: %w", err)
+		} else if !flagQuiet {
+			fmt.Fprintf(os.Stderr, "Wrote %s\n", sprintVelocityXLSX)
+		}
+	}
+	if sprintVelocityMarkdown != "" {
+		if err := tbl.WriteMarkdown(sprintVelocityMarkdown, 0600, "\n", true); err != nil {
+			return fmt.Errorf("failed to write Markdown: %w", err)
+		} else if !flagQuiet {
+			fmt.Fprintf(os.Stderr, "Wrote %s\n", sprintVelocityMarkdown)
+		}
+	}
+	if getOutputFormat() == OutputTable {
+		writeVelocityTable(r)
+		return nil
+	}
+	return outputResult(cmd, r)
+}
+
+func writeVelocityTable(r *rest.VelocityReport) {
+	fmt.Printf("Board %d, points field %q, %d-sprint average\n\n", r.BoardID, r.PointsField, r.Window)
+	fmt.Printf("%-20s  %-10s  %-10s  %9s  %9s  %9s  %9s  %9s  %7s\n",
+		"SPRINT", "START", "END", "COMMITTED", "ADDED", "REMOVED", "COMPLETED", "CARRYOVER", "AVERAGE")
+	fmt.Printf("%s  %s  %s  %s  %s  %s  %s  %s  %s\n", strings.Repeat("-", 20), strings.Repeat("-", 10), strings.Repeat("-", 10),
+		strings.Repeat("-", 9), strings.Repeat("-", 9), strings.Repeat("-", 9), strings.Repeat("-", 9), strings.Repeat("-", 9), strings.Repeat("-", 7))
+	for _, sv := range r.Sprints {
+		fmt.Printf("%-20s  %-10s  %-10s  %9s  %9s  %9s  %9s  %9s  %7.1f\n",
+			truncateString(sv.SprintName, 20), sv.Start.Format(time.DateOnly), sv.End.Format(time.DateOnly),
+			velocityCell(sv.CommittedPoints, sv.CommittedIssues), velocityCell(sv.AddedPoints, sv.AddedIssues),
+			velocityCell(sv.RemovedPoints, sv.RemovedIssues), velocityCell(sv.CompletedPoints, sv.CompletedIssues),
+			velocityCell(sv.CarryOverPoints, sv.CarryOverIssues), sv.AveragePoints)
+	}
+	fmt.Printf("\nPoints (issues). Average completed: %.1f points, %.1f issues\n", r.AveragePoints, r.AverageIssues)
+}
+
+func velocityCell(points float64, issues int) string {
+	return fmt.Sprintf("%g (%d)", points, issues)
+}
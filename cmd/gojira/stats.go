@@ -36,14 +36,24 @@ Examples:
   # Output formats (default: toon)
   gojira stats --jql "..." --by status --format toon   # Token-optimized (default)
   gojira stats --jql "..." --by status --format json   # JSON
-  gojira stats --jql "..." --by status --format table  # Human-readable`,
+  gojira stats --jql "..." --by status --format table  # Human-readable
+
+  # Cycle time percentiles by issue type, using --workflow or Jira status categories
+  gojira stats --jql "project = FOO AND resolved >= -90d" --metric cycle-time --by type --format table
+
+  # Lead time by project, with per-issue times written to Excel
+  gojira stats --jql "project in (FOO, BAR)" --metric lead-time --by project --xlsx lead-time.xlsx`,
 	RunE: runStats,
 }
 
 var (
-	statsJQL    string
-	statsBy     string
-	statsFormat string
+	statsJQL           string
+	statsBy            string
+	statsFormat        string
+	statsMetric        string
+	statsStartStatuses string
+	statsDoneStatuses  string
+	statsXLSX          string
 )
 
 func init() {
@@ -52,9 +62,12 @@ func init() {
 	statsCmd.Flags().StringVar(&statsJQL, "jql", "", "JQL query to search issues (required)")
 	statsCmd.Flags().StringVar(&statsBy, "by", "", "Field to group by: status, type, priority, assignee, project, or customfield_XXXXX (required)")
 	statsCmd.Flags().StringVar(&statsFormat, "format", "toon", "Output format: toon (default), json, table")
+	statsCmd.Flags().StringVar(&statsMetric, "metric", "", "Flow metric instead of counts: cycle-time or lead-time (--by is then type, project or empty)")
+	statsCmd.Flags().StringVar(&statsStartStatuses, "start-statuses", "", "Comma-separated statuses that start cycle time (default: --workflow in-progress stages, else Jira \"In Progress\" category)")
+	statsCmd.Flags().StringVar(&statsDoneStatuses, "done-statuses", "", "Comma-separated statuses that end cycle time (default: --workflow done stage, else Jira \"Done\" category)")
+	statsCmd.Flags().StringVar(&statsXLSX, "xlsx", "", "With --metric, also write summary and per-issue flow times to an XLSX file")
 
 	_ = statsCmd.MarkFlagRequired("jql")
-	_ = statsCmd.MarkFlagRequired("by")
 }
 
 // StatResult represents a single count result.
@@ -76,6 +89,11 @@ func runStats(cmd *cobra.Command, args []string) error {
 	if format != "toon" && format != "json" && format != "table" {
 		return fmt.Errorf("invalid format %q: use toon, json, or table", statsFormat)
 	}
+	if statsMetric != "" {
+		return runStatsFlow(format)
+	} else if statsBy == "" {
+		return fmt.Errorf("--by is required unless --metric is set")
+	}
 
 	// Get client
 	client, err := NewClientFromOptions(getAuthOptions())
@@ -0,0 +1,176 @@
+package main
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"os"
+	"sort"
+	"strings"
+
+	"github.com/grokify/gocharts/v2/data/table"
+	"github.com/grokify/gojira"
+	"github.com/grokify/gojira/rest"
+	toon "github.com/toon-format/toon-go"
+)
+
+// FlowStatResult represents duration statistics in days for one group.
+type FlowStatResult struct {
+	Group string  `json:"group" toon:"g"`
+	Count int     `json:"count" toon:"n"`
+	Mean  float64 `json:"mean" toon:"avg"`
+	P50   float64 `json:"p50" toon:"p50"`
+	P85   float64 `json:"p85" toon:"p85"`
+	P95   float64 `json:"p95" toon:"p95"`
+	Min   float64 `json:"min" toon:"min"`
+	Max   float64 `json:"max" toon:"max"`
+}
+
+// FlowStatsOutput represents the full flow metric output.
+type FlowStatsOutput struct {
+	Metric   string           `json:"metric" toon:"m"`
+	GroupBy  string           `json:"groupBy,omitempty" toon:"b"`
+	Unit     string           `json:"unit" toon:"u"`
+	Total    int              `json:"total" toon:"t"`
+	Measured int              `json:"measured" toon:"c"`
+	Results  []FlowStatResult `json:"results" toon:"r"`
+}
+
+func runStatsFlow(format string) error {
+	metric := strings.ToLower(strings.TrimSpace(statsMetric))
+	if metric != rest.FlowMetricCycleTime && metric != rest.FlowMetricLeadTime {
+		return fmt.Errorf("invalid metric %q: use %s or %s", statsMetric, rest.FlowMetricCycleTime, rest.FlowMetricLeadTime)
+	}
+	groupFunc, err := rest.FlowTimeGroupFuncBySlug(statsBy)
+	if err != nil {
+		return fmt.Errorf("invalid --by %q for --metric: use type, project or leave empty", statsBy)
+	}
+
+	client, err := NewClientFromOptions(getAuthOptions())
+	if err != nil {
+		return fmt.Errorf("authentication failed: %w", err)
+	}
+	ctx := context.Background()
+
+	opts := &rest.FlowTimesOptions{
+		StartStatuses: rest.ParseFieldsCSV(statsStartStatuses),
+		DoneStatuses:  rest.ParseFieldsCSV(statsDoneStatuses)}
+	if metric == rest.FlowMetricCycleTime && (len(opts.StartStatuses) == 0 || len(opts.DoneStatuses) == 0) {
+		var scc *gojira.StatusCategoryConfig
+		if client.Config != nil {
+			scc = client.Config.StatusConfig
+		}
+		if scc == nil {
+			if scc, err = client.StatusCategoryConfigFromJiraCategories(ctx, nil); err != nil {
+				return fmt.Errorf("failed to read status categories: %w", err)
+			}
+		}
+		if len(opts.StartStatuses) == 0 {
+			opts.StartStatuses = scc.StatusesInProgress()
+		}
+		if len(opts.DoneStatuses) == 0 {
+			opts.DoneStatuses = scc.StatusesDone()
+		}
+	}
+
+	if !flagQuiet {
+		fmt.Fprintf(os.Stderr, "Searching issues...\n")
+	}
+	sopts := rest.SearchOptions{Fields: []string{"issuetype", "project", "status", "created", "resolutiondate"}}
+	if metric == rest.FlowMetricCycleTime {
+		sopts.Expand = []string{rest.ExpandFieldChangelog}
+	}
+	set, err := client.IssueAPI.SearchIssuesSet(statsJQL, sopts)
+	if err != nil {
+		return fmt.Errorf("search failed: %w", err)
+	}
+	if metric == rest.FlowMetricCycleTime {
+		if err := client.IssueAPI.IssuesSetLoadChangelogs(ctx, set); err != nil {
+			return fmt.Errorf("failed to load changelogs: %w", err)
+		}
+	}
+	if !flagQuiet {
+		fmt.Fprintf(os.Stderr, "Found %d issues\n", set.Len())
+	}
+
+	fts, err := set.FlowTimes(opts)
+	if err != nil {
+		return err
+	}
+	stats, err := rest.FlowTimesStats(fts, metric, groupFunc)
+	if err != nil {
+		return err
+	}
+
+	output := FlowStatsOutput{
+		Metric:  metric,
+		GroupBy: strings.ToLower(strings.TrimSpace(statsBy)),
+		Unit:    "days",
+		Total:   len(fts)}
+	for group, s := range stats {
+		output.Measured += s.Count
+		output.Results = append(output.Results, FlowStatResult{
+			Group: group,
+			Count: s.Count,
+			Mean:  roundDays(rest.DurationDays(s.Mean)),
+			P50:   roundDays(rest.DurationDays(s.P50)),
+			P85:   roundDays(rest.DurationDays(s.P85)),
+			P95:   roundDays(rest.DurationDays(s.P95)),
+			Min:   roundDays(rest.DurationDays(s.Min)),
+			Max:   roundDays(rest.DurationDays(s.Max))})
+	}
+	sort.Slice(output.Results, func(i, j int) bool {
+		if output.Results[i].Count != output.Results[j].Count {
+			return output.Results[i].Count > output.Results[j].Count
+		}
+		return output.Results[i].Group < output.Results[j].Group
+	})
+
+	if statsXLSX != "" {
+		groupColName := "Group"
+		if output.GroupBy != "" {
+			groupColName = strings.ToUpper(output.GroupBy[:1]) + output.GroupBy[1:]
+		}
+		tblStats := rest.FlowTimesStatsTable(stats, groupColName)
+		tblStats.Name = metric
+		tblIssues := rest.FlowTimesTable(fts)
+		tblIssues.Name = "issues"
+		if err := table.WriteXLSX(statsXLSX, []*table.Table{tblStats, tblIssues}); err != nil {
+			return fmt.Errorf("failed to write XLSX: %w", err)
+		} else if !flagQuiet {
+			fmt.Fprintf(os.Stderr, "Wrote %s\n", statsXLSX)
+		}
+	}
+
+	return outputFlowStats(output, format)
+}
+
+func roundDays(d float64) float64 {
+	return float64(int64(d*100+0.5)) / 100
+}
+
+func outputFlowStats(output FlowStatsOutput, format string) error {
+	switch format {
+	case "json":
+		data, err := json.MarshalIndent(output, "", "  ")
+		if err != nil {
+			return err
+		}
+		fmt.Println(string(data))
+	case "table":
+		fmt.Printf("%s (%s), %d of %d issues measured\n\n", output.Metric, output.Unit, output.Measured, output.Total)
+		fmt.Printf("%-30s  %6s  %8s  %8s  %8s  %8s  %8s  %8s\n", "GROUP", "COUNT", "MEAN", "P50", "P85", "P95", "MIN", "MAX")
+		fmt.Printf("%s  %s  %s\n", strings.Repeat("-", 30), "------", strings.Repeat("-", 58))
+		for _, r := range output.Results {
+			fmt.Printf("%-30s  %6d  %8.2f  %8.2f  %8.2f  %8.2f  %8.2f  %8.2f\n",
+				truncateString(r.Group, 30), r.Count, r.Mean, r.P50, r.P85, r.P95, r.Min, r.Max)
+		}
+	default: // toon
+		data, err := toon.Marshal(output)
+		if err != nil {
+			return err
+		}
+		fmt.Println(string(data))
+	}
+	return nil
+}
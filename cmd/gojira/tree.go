@@ -0,0 +1,131 @@
+package main
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"strings"
+
+	"github.com/spf13/cobra"
+	toon "github.com/toon-format/toon-go"
+
+	"github.com/grokify/gojira"
+	"github.com/grokify/gojira/rest"
+)
+
+var treeCmd = &cobra.Command{
+	Use:   "tree <issue-key>",
+	Short: "Show an issue's parent/child hierarchy",
+	Long: `Show the hierarchy around an issue: its ancestors up to --ancestors levels,
+and its descendants down to --depth levels. Ancestors' other children are not
+shown.
+
+Examples:
+  # Epic with its stories and sub-tasks
+  gojira tree ABC-100 --table
+
+  # Where does this sub-task fit?
+  gojira tree ABC-123 --depth 0 --table
+
+  # Token-optimized output for assistants
+  gojira tree ABC-100 --toon`,
+	Args: cobra.ExactArgs(1),
+	RunE: runTree,
+}
+
+var (
+	treeDepth     int
+	treeAncestors int
+)
+
+func init() {
+	rootCmd.AddCommand(treeCmd)
+	treeCmd.Flags().IntVar(&treeDepth, "depth", 3, "Levels of descendants to fetch")
+	treeCmd.Flags().IntVar(&treeAncestors, "ancestors", 5, "Levels of ancestors to fetch")
+}
+
+var treeFields = []string{"summary", "issuetype", "status", "assignee", "parent"}
+
+func runTree(cmd *cobra.Command, args []string) error {
+	key := strings.ToUpper(strings.TrimSpace(args[0]))
+	client, err := NewClientFromOptions(getAuthOptions())
+	if err != nil {
+		return fmt.Errorf("authentication failed: %w", err)
+	}
+	if !flagQuiet {
+		fmt.Fprintf(os.Stderr, "Reading hierarchy...\n")
+	}
+	ctx := context.Background()
+	so := rest.SearchOptions{Fields: treeFields}
+	ii, err := rest.CollectIssues(client.IssueAPI.SearchIssuesSeq(ctx, gojira.JQL{KeysIncl: [][]string{{key}}}.String(), so))
+	if err != nil {
+		return fmt.Errorf("search failed: %w", err)
+	}
+	set := rest.NewIssuesSet(client.Config)
+	if err := set.Add(ii...); err != nil {
+		return err
+	} else if _, ok := set.Items[key]; !ok {
+		return fmt.Errorf("issue not found (%s)", key)
+	} else if err := client.IssueAPI.IssuesSetLoadChildren(ctx, set, treeDepth, so); err != nil {
+		return fmt.Errorf("loading children failed: %w", err)
+	} else if err := client.IssueAPI.IssuesSetLoadAncestors(ctx, set, treeAncestors, so); err != nil {
+		return fmt.Errorf("loading ancestors failed: %w", err)
+	}
+
+	rootKey, err := set.TreeRootKey(key)
+	if err != nil {
+		return err
+	}
+	tree, err := set.Tree(rootKey)
+	if err != nil {
+		return err
+	}
+
+	switch getOutputFormat() {
+	case OutputTable:
+		writeTree(tree, key)
+		return nil
+	case OutputTOON:
+		data, err := toon.Marshal(tree)
+		if err != nil {
+			return err
+		}
+		fmt.Println(string(data))
+		return nil
+	default:
+		return outputResult(cmd, tree)
+	}
+}
+
+// writeTree prints the tree with one issue per line, marking the issue the tree was requested for.
+func writeTree(tree *rest.IssueTree, key string) {
+	var write func(node *rest.IssueTree, prefix, branch string)
+	write = func(node *rest.IssueTree, prefix, branch string) {
+		parts := []string{node.Key}
+		for _, s := range []string{node.Type, "[" + node.Status + "]", node.Assignee, truncateString(node.Summary, 60)} {
+			if s != "" && s != "[]" {
+				parts = append(parts, s)
+			}
+		}
+		line := strings.Join(parts, "  ")
+		if node.Key == key {
+			line += "  <"
+		}
+		fmt.Println(prefix + branch + line)
+		switch branch {
+		case "├── ":
+			prefix += "│   "
+		case "└── ":
+			prefix += "    "
+		}
+		for i, c := range node.Children {
+			if i == len(node.Children)-1 {
+				write(c, prefix, "└── ")
+			} else {
+				write(c, prefix, "├── ")
+			}
+		}
+	}
+	write(tree, "", "")
+	fmt.Printf("\n%d issues\n", tree.Len())
+}
@@ -0,0 +1,83 @@
+package main
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"os"
+
+	"github.com/spf13/cobra"
+	"gopkg.in/yaml.v3"
+
+	"github.com/grokify/gojira"
+)
+
+var workflowCmd = &cobra.Command{
+	Use:   "workflow",
+	Short: "Export a workflow YAML file mapping statuses to stages",
+	Long: `Export a workflow YAML file that maps Jira statuses to stages.
+
+Stages are built from a board's columns (--board) or from Jira's status
+categories (--categories). Load the file with the global --workflow flag so
+every report uses the same workflow mapping.
+
+Examples:
+  # One stage per board column
+  gojira workflow --board 42 -o workflow.yaml
+
+  # To Do, In Progress and Done from Jira status categories
+  gojira workflow --categories
+
+  # Use it for reports
+  gojira report cfd --jql "project = FOO" --meta-stage --workflow workflow.yaml`,
+	Args: cobra.NoArgs,
+	RunE: runWorkflow,
+}
+
+var (
+	workflowBoardID    int
+	workflowCategories bool
+	workflowOutput     string
+)
+
+func init() {
+	rootCmd.AddCommand(workflowCmd)
+	workflowCmd.Flags().IntVar(&workflowBoardID, "board", 0, "Board ID; stages are the board's columns")
+	workflowCmd.Flags().BoolVar(&workflowCategories, "categories", false, "Build stages from Jira status categories")
+	workflowCmd.Flags().StringVarP(&workflowOutput, "output", "o", "", "Output YAML file (default: stdout)")
+	workflowCmd.MarkFlagsMutuallyExclusive("board", "categories")
+}
+
+func runWorkflow(cmd *cobra.Command, args []string) error {
+	if workflowBoardID == 0 && !workflowCategories {
+		return errors.New("set one of --board or --categories")
+	}
+	client, err := NewClientFromOptions(getAuthOptions())
+	if err != nil {
+		return fmt.Errorf("authentication failed: %w", err)
+	}
+	ctx := context.Background()
+	var scc *gojira.StatusCategoryConfig
+	if workflowBoardID != 0 {
+		scc, err = client.BoardAPI.StatusCategoryConfig(ctx, workflowBoardID)
+	} else {
+		scc, err = client.StatusCategoryConfigFromJiraCategories(ctx, gojira.DefaultStageSet())
+	}
+	if err != nil {
+		return fmt.Errorf("failed to build workflow: %w", err)
+	}
+	if workflowOutput != "" {
+		if err := scc.WriteFileYAML(workflowOutput, 0600); err != nil {
+			return fmt.Errorf("failed to write workflow: %w", err)
+		} else if !flagQuiet {
+			fmt.Fprintf(os.Stderr, "Wrote %s\n", workflowOutput)
+		}
+		return nil
+	}
+	b, err := yaml.Marshal(scc)
+	if err != nil {
+		return err
+	}
+	_, err = os.Stdout.Write(b)
+	return err
+}
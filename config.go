@@ -13,12 +13,19 @@ type Config struct {
 	WorkingHoursPerDay float32
 	WorkingDaysPerWeek float32
 	StatusConfig       *StatusCategoryConfig
+	RetryMaxAttempts   int           // total attempts per request; values <= 1 disable retries
+	RetryMaxWait       time.Duration // maximum server-requested wait before giving up; 0 means no limit
+	RateLimitPerSecond float64       // client-side request rate; 0 disables rate limiting
+	RateLimitBurst     int
+	RateLimitAdaptive  bool // slow down when Jira returns rate-limit headers
 }
 
 func NewConfigDefault() *Config {
 	return &Config{
 		WorkingHoursPerDay: WorkingHoursPerDayDefault,
-		WorkingDaysPerWeek: WorkingDaysPerWeekDefault}
+		WorkingDaysPerWeek: WorkingDaysPerWeekDefault,
+		RetryMaxAttempts:   RetryMaxAttemptsDefault,
+		RetryMaxWait:       RetryMaxWaitDefault}
 }
 
 func (c *Config) SecondsToWorkingDays(sec int) float32 {
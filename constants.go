@@ -7,21 +7,28 @@ import (
 
 const (
 	// These are used by "GoJira" but not necessarily "Jira"
+	FieldAssignee    = "assignee"
+	FieldComponent   = "component"
 	FieldCreatedDate = "createddate"
 	FieldDueDate     = "duedate"
 	FieldFilter      = "filter"
+	FieldFixVersion  = "fixVersion"
 	FieldIssue       = "issue" // issue keys
 	FieldKey         = "key"
 	FieldLabels      = "labels"
 	FieldParent      = "parent"
+	FieldPriority    = "priority"
 	FieldProject     = "project" // project keys
 	FieldProjectKey  = "projectkey"
+	FieldReporter    = "reporter"
 	FieldResolution  = "resolution"
+	FieldSprint      = "sprint"
 	FieldStatus      = "status"
 	FieldSummary     = "summary"
 	FieldText        = "text"
 	FieldType        = "type"
 	FieldUpdated     = "updated"
+	FieldWatcher     = "watcher"
 
 	CalcCreatedAgeDays = "createdagedays"
 	CalcCreatedMonth   = "createdmonth"
@@ -64,6 +71,8 @@ const (
 	MetaStagePrefixReadyFor = "Ready for "
 	MetaStagePrefixIn       = "In "
 
+	OperatorEQ        = "="
+	OperatorNE        = "!="
 	OperatorGT        = ">"
 	OperatorGTE       = ">="
 	OperatorLT        = "<"
@@ -74,6 +83,15 @@ const (
 	operatorORSpaces  = " OR "
 	OperatorLike      = "~"
 	OperatorNotLike   = "!~"
+	OperatorIn        = "IN"
+	OperatorNotIn     = "NOT IN"
+	OperatorIs        = "IS"
+	OperatorIsNot     = "IS NOT"
+	OperatorWas       = "WAS"
+	OperatorWasNot    = "WAS NOT"
+	OperatorWasIn     = "WAS IN"
+	OperatorWasNotIn  = "WAS NOT IN"
+	OperatorChanged   = "CHANGED"
 
 	MetaStageReadyForPlanning    = MetaStagePrefixReadyFor + StagePlanning
 	MetaStageInPlanning          = MetaStagePrefixIn + StagePlanning
@@ -92,6 +110,9 @@ const (
 	WorkingHoursPerDayDefault float32 = 8.0
 	WorkingDaysPerWeekDefault float32 = 5.0
 
+	RetryMaxAttemptsDefault = 4
+	RetryMaxWaitDefault     = 2 * time.Minute
+
 	JiraXMLGenerated = time.UnixDate // "Fri Jul 28 01:07:16 UTC 2023"
 
 	JQLMaxResults = 100
@@ -0,0 +1,166 @@
+package core
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/grokify/gojira/rest"
+)
+
+// AgingHistoryDaysDefault is the history window for the cycle-time baseline when no history JQL is given.
+const AgingHistoryDaysDefault = 180
+
+// AgingWIPInput represents the input for an aging work-in-progress report.
+type AgingWIPInput struct {
+	// JQL selects the work to check, e.g. `project = FOO AND statusCategory = "In Progress"`.
+	JQL string `json:"jql"`
+
+	// HistoryJQL selects completed issues for the cycle-time baseline. If empty, issues in the
+	// same projects resolved in the last `AgingHistoryDaysDefault` days are used.
+	HistoryJQL string `json:"history_jql,omitempty"`
+
+	// Percentile is the outlier threshold: 50, 85 or 95 (default 85).
+	Percentile int `json:"percentile,omitempty"`
+}
+
+// AgingWIPItem represents one aging issue with ages in days.
+type AgingWIPItem struct {
+	Key               string  `json:"key" toon:"k"`
+	Summary           string  `json:"summary" toon:"s"`
+	Type              string  `json:"type" toon:"t"`
+	Status            string  `json:"status" toon:"st"`
+	Stage             string  `json:"stage" toon:"sg"`
+	Assignee          string  `json:"assignee,omitempty" toon:"a"`
+	StageAgeDays      float64 `json:"stageAgeDays" toon:"sa"`
+	InProgressAgeDays float64 `json:"inProgressAgeDays" toon:"ia"`
+	BaselineGroup     string  `json:"baselineGroup,omitempty" toon:"bg"`
+	P50Days           float64 `json:"p50Days,omitempty" toon:"p50"`
+	P85Days           float64 `json:"p85Days,omitempty" toon:"p85"`
+	P95Days           float64 `json:"p95Days,omitempty" toon:"p95"`
+	PercentileDays    float64 `json:"percentileDays,omitempty" toon:"pd"` // cycle time at the report's percentile
+	Level             string  `json:"level,omitempty" toon:"l"`
+	Outlier           bool    `json:"outlier" toon:"o"`
+}
+
+// AgingWIPResult represents an aging work-in-progress report.
+type AgingWIPResult struct {
+	Now        time.Time      `json:"now" toon:"now"`
+	HistoryJQL string         `json:"historyJql" toon:"h"`
+	Baseline   int            `json:"baselineIssues" toon:"b"`
+	Percentile int            `json:"percentile" toon:"p"`
+	Total      int            `json:"total" toon:"n"`
+	Outliers   int            `json:"outliers" toon:"x"`
+	Items      []AgingWIPItem `json:"items" toon:"i"`
+}
+
+// AgingWIP searches for work in progress and compares its age to the cycle times of completed
+// issues. Statuses are mapped to stages using the client's `StatusCategoryConfig`, or the Jira
+// status categories if it is not set.
+func AgingWIP(ctx context.Context, client *rest.Client, input *AgingWIPInput) (*AgingWIPResult, error) {
+	if input == nil || strings.TrimSpace(input.JQL) == "" {
+		return nil, errors.New("jql is required")
+	}
+	percentile := input.Percentile
+	if percentile == 0 {
+		percentile = rest.AgingPercentileDefault
+	}
+	scc := client.Config.StatusConfig
+	if scc == nil {
+		var err error
+		if scc, err = client.StatusCategoryConfigFromJiraCategories(ctx, nil); err != nil {
+			return nil, fmt.Errorf("get status categories: %w", err)
+		}
+	}
+
+	fields := []string{"summary", "issuetype", "project", "status", "assignee", "created", "resolutiondate"}
+	wip, err := client.IssueAPI.SearchIssuesSet(input.JQL, rest.SearchOptions{
+		Fields: fields,
+		Expand: []string{rest.ExpandFieldChangelog}})
+	if err != nil {
+		return nil, fmt.Errorf("search: %w", err)
+	} else if err := client.IssueAPI.IssuesSetLoadChangelogs(ctx, wip); err != nil {
+		return nil, fmt.Errorf("load changelogs: %w", err)
+	}
+
+	historyJQL := strings.TrimSpace(input.HistoryJQL)
+	if historyJQL == "" {
+		var projectKeys []string
+		for _, iss := range wip.Items {
+			im := rest.NewIssueMore(&iss)
+			if pk := im.ProjectKey(); pk != "" && !slices.Contains(projectKeys, pk) {
+				projectKeys = append(projectKeys, pk)
+			}
+		}
+		if len(projectKeys) > 0 {
+			slices.Sort(projectKeys)
+			historyJQL = fmt.Sprintf("project in (%s) AND statusCategory = Done AND resolved >= -%dd",
+				strings.Join(projectKeys, ", "), AgingHistoryDaysDefault)
+		}
+	}
+	opts := &rest.AgingWIPOptions{
+		Now:                time.Now(),
+		InProgressStatuses: scc.StatusesInProgress(),
+		DoneStatuses:       scc.StatusesDone(),
+		Baseline:           map[string]rest.DurationStats{},
+		Percentile:         percentile}
+	result := &AgingWIPResult{Now: opts.Now, HistoryJQL: historyJQL, Percentile: percentile}
+	if historyJQL != "" {
+		history, err := client.IssueAPI.SearchIssuesSet(historyJQL, rest.SearchOptions{
+			Fields: fields,
+			Expand: []string{rest.ExpandFieldChangelog}})
+		if err != nil {
+			return nil, fmt.Errorf("history search: %w", err)
+		} else if err := client.IssueAPI.IssuesSetLoadChangelogs(ctx, history); err != nil {
+			return nil, fmt.Errorf("load history changelogs: %w", err)
+		}
+		fts, err := history.FlowTimes(&rest.FlowTimesOptions{StartStatuses: opts.InProgressStatuses, DoneStatuses: opts.DoneStatuses})
+		if err != nil {
+			return nil, err
+		}
+		if opts.Baseline, err = rest.CycleTimeBaseline(fts); err != nil {
+			return nil, err
+		}
+		result.Baseline = opts.Baseline[rest.FlowGroupAll].Count
+	}
+
+	cfg := *wip.Config // do not change the client config
+	cfg.StatusConfig = scc
+	wip.Config = &cfg
+	items, err := wip.AgingWIP(opts)
+	if err != nil {
+		return nil, err
+	}
+	for _, item := range items {
+		out := AgingWIPItem{
+			Key:               item.Key,
+			Summary:           item.Summary,
+			Type:              item.Type,
+			Status:            item.Status,
+			Stage:             item.Stage,
+			Assignee:          item.Assignee,
+			StageAgeDays:      roundDays(item.StageAge),
+			InProgressAgeDays: roundDays(item.InProgressAge),
+			BaselineGroup:     item.BaselineGroup,
+			Level:             item.Level,
+			Outlier:           item.Outlier}
+		if item.BaselineCount > 0 {
+			out.P50Days, out.P85Days, out.P95Days = roundDays(item.P50), roundDays(item.P85), roundDays(item.P95)
+			out.PercentileDays = map[int]float64{50: out.P50Days, 85: out.P85Days, 95: out.P95Days}[percentile]
+		}
+		if out.Outlier {
+			result.Outliers++
+		}
+		result.Items = append(result.Items, out)
+	}
+	result.Total = len(result.Items)
+	return result, nil
+}
+
+func roundDays(d time.Duration) float64 {
+	return math.Round(rest.DurationDays(d)*100) / 100
+}
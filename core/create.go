@@ -131,10 +131,7 @@ func CreateIssue(ctx context.Context, client *rest.Client, input *IssueInput) (*
 	// Create the issue
 	created, resp, err := client.JiraClient.Issue.CreateWithContext(ctx, issue)
 	if err != nil {
-		if resp != nil && resp.StatusCode >= 400 {
-			return nil, fmt.Errorf("create issue failed (status %d): %w", resp.StatusCode, err)
-		}
-		return nil, fmt.Errorf("create issue: %w", err)
+		return nil, fmt.Errorf("create issue: %w", rest.JiraResponseError(resp, err))
 	}
 
 	return &IssueResult{
@@ -0,0 +1,70 @@
+package forecast
+
+import (
+	"testing"
+	"time"
+
+	"github.com/grokify/mogo/time/timeutil"
+)
+
+func TestNewThroughput(t *testing.T) {
+	start := time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC)
+	times := []time.Time{
+		time.Date(2026, 1, 1, 10, 0, 0, 0, time.UTC),
+		time.Date(2026, 1, 3, 10, 0, 0, 0, time.UTC),
+		time.Date(2026, 1, 9, 10, 0, 0, 0, time.UTC),
+		time.Date(2026, 1, 16, 10, 0, 0, 0, time.UTC), // partial third week
+		{}}
+	tp, err := NewThroughput(times, start, time.Date(2026, 1, 18, 0, 0, 0, 0, time.UTC), timeutil.IntervalWeek)
+	if err != nil {
+		t.Fatalf("NewThroughput() error = %v", err)
+	}
+	if len(tp.Counts) != 2 || tp.Counts[0] != 2 || tp.Counts[1] != 1 {
+		t.Errorf("NewThroughput() mismatch: want ([2 1]), got (%v)", tp.Counts)
+	}
+	if _, err := NewThroughput(times, start, start.AddDate(0, 0, 3), timeutil.IntervalWeek); err != ErrNoThroughputHistory {
+		t.Errorf("NewThroughput() error mismatch: want (%v), got (%v)", ErrNoThroughputHistory, err)
+	}
+}
+
+func TestMonteCarlo(t *testing.T) {
+	start := time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC)
+	mc := NewMonteCarlo(&Throughput{Interval: timeutil.IntervalWeek, Start: start, Counts: []int{5, 5, 5}}, 1)
+
+	hm, err := mc.HowManyByDate(start, start.AddDate(0, 0, 30), DefaultConfidences())
+	if err != nil {
+		t.Fatalf("MonteCarlo.HowManyByDate() error = %v", err)
+	}
+	for _, r := range hm {
+		if r.Items != 20 {
+			t.Errorf("MonteCarlo.HowManyByDate() [%v] mismatch: want (20), got (%d)", r.Confidence, r.Items)
+		}
+	}
+
+	when, err := mc.When(12, start, DefaultConfidences())
+	if err != nil {
+		t.Fatalf("MonteCarlo.When() error = %v", err)
+	}
+	for _, r := range when {
+		if want := start.AddDate(0, 0, 21); r.Periods != 3 || !r.Date.Equal(want) {
+			t.Errorf("MonteCarlo.When() [%v] mismatch: want (3, %s), got (%d, %s)", r.Confidence, want, r.Periods, r.Date)
+		}
+	}
+
+	mc.Throughput.Counts = []int{0, 2, 10}
+	when, err = mc.When(40, start, []float64{0.5, 0.95})
+	if err != nil {
+		t.Fatalf("MonteCarlo.When() error = %v", err)
+	} else if when[0].Periods > when[1].Periods {
+		t.Errorf("MonteCarlo.When() mismatch: want p50 <= p95, got (%d, %d)", when[0].Periods, when[1].Periods)
+	}
+	again, _ := mc.When(40, start, []float64{0.5, 0.95})
+	if again[0].Periods != when[0].Periods || again[1].Periods != when[1].Periods {
+		t.Errorf("MonteCarlo.When() not reproducible with seed: got (%v) and (%v)", when, again)
+	}
+
+	mc.Throughput.Counts = []int{0, 0}
+	if _, err := mc.When(1, start, nil); err != ErrNoThroughput {
+		t.Errorf("MonteCarlo.When() error mismatch: want (%v), got (%v)", ErrNoThroughput, err)
+	}
+}
@@ -0,0 +1,147 @@
+package forecast
+
+import (
+	"errors"
+	"fmt"
+	"math"
+	"math/rand/v2"
+	"slices"
+	"time"
+)
+
+const (
+	TrialsDefault     = 10000
+	MaxPeriodsDefault = 1000
+)
+
+var (
+	ErrNoThroughput         = errors.New("throughput is zero in every interval")
+	ErrConfidenceOutOfRange = errors.New("confidence must be greater than 0 and at most 1")
+)
+
+// DefaultConfidences returns the 50%, 85% and 95% confidence levels.
+func DefaultConfidences() []float64 { return []float64{0.5, 0.85, 0.95} }
+
+// MonteCarlo forecasts delivery by sampling historical throughput with replacement. A fixed
+// `Seed` makes results reproducible.
+type MonteCarlo struct {
+	Throughput *Throughput
+	Trials     int    // number of simulations; zero uses `TrialsDefault`
+	Seed       uint64 // random seed
+	MaxPeriods int    // `When` gives up after this many intervals; zero uses `MaxPeriodsDefault`
+}
+
+// NewMonteCarlo returns a `MonteCarlo` with default trials.
+func NewMonteCarlo(tp *Throughput, seed uint64) *MonteCarlo {
+	return &MonteCarlo{Throughput: tp, Trials: TrialsDefault, Seed: seed, MaxPeriods: MaxPeriodsDefault}
+}
+
+// HowManyResult is the number of items that will be completed with at least `Confidence` probability.
+type HowManyResult struct {
+	Confidence float64 `json:"confidence"`
+	Items      int     `json:"items"`
+}
+
+// WhenResult is the number of intervals, and the resulting date, by which the items will be
+// completed with at least `Confidence` probability.
+type WhenResult struct {
+	Confidence float64   `json:"confidence"`
+	Periods    int       `json:"periods"`
+	Date       time.Time `json:"date"`
+}
+
+// HowMany simulates `periods` intervals and returns, for each confidence, the number of items
+// completed in at least that share of trials.
+func (mc *MonteCarlo) HowMany(periods int, confidences []float64) ([]HowManyResult, error) {
+	if err := mc.validate(confidences); err != nil {
+		return nil, err
+	} else if periods < 0 {
+		return nil, fmt.Errorf("periods cannot be negative (%d)", periods)
+	}
+	r := mc.rand()
+	counts := mc.Throughput.Counts
+	totals := make([]int, mc.trials())
+	for i := range totals {
+		for range periods {
+			totals[i] += counts[r.IntN(len(counts))]
+		}
+	}
+	slices.Sort(totals)
+	var out []HowManyResult
+	for _, c := range confidences {
+		// at least `Items` in c of trials is the (1-c) percentile of ascending totals.
+		out = append(out, HowManyResult{Confidence: c, Items: totals[nearestRankIndex(len(totals), 1-c)]})
+	}
+	return out, nil
+}
+
+// HowManyByDate simulates the complete intervals from `from` to `until`. See `HowMany`.
+func (mc *MonteCarlo) HowManyByDate(from, until time.Time, confidences []float64) ([]HowManyResult, error) {
+	if mc.Throughput == nil {
+		return nil, ErrNoThroughputHistory
+	}
+	return mc.HowMany(mc.Throughput.PeriodsUntil(from, until), confidences)
+}
+
+// When simulates completing `items` and returns, for each confidence, the number of intervals
+// needed in at most that share of trials, with the date that many intervals after `from`.
+func (mc *MonteCarlo) When(items int, from time.Time, confidences []float64) ([]WhenResult, error) {
+	if err := mc.validate(confidences); err != nil {
+		return nil, err
+	} else if items < 0 {
+		return nil, fmt.Errorf("items cannot be negative (%d)", items)
+	} else if mc.Throughput.Total() == 0 && items > 0 {
+		return nil, ErrNoThroughput
+	}
+	maxPeriods := mc.MaxPeriods
+	if maxPeriods <= 0 {
+		maxPeriods = MaxPeriodsDefault
+	}
+	r := mc.rand()
+	counts := mc.Throughput.Counts
+	periods := make([]int, mc.trials())
+	for i := range periods {
+		for done := 0; done < items; periods[i]++ {
+			if periods[i] >= maxPeriods {
+				return nil, fmt.Errorf("forecast exceeds max periods (%d)", maxPeriods)
+			}
+			done += counts[r.IntN(len(counts))]
+		}
+	}
+	slices.Sort(periods)
+	var out []WhenResult
+	for _, c := range confidences {
+		p := periods[nearestRankIndex(len(periods), c)]
+		out = append(out, WhenResult{Confidence: c, Periods: p, Date: mc.Throughput.AddPeriods(from, p)})
+	}
+	return out, nil
+}
+
+func (mc *MonteCarlo) validate(confidences []float64) error {
+	if mc.Throughput == nil || len(mc.Throughput.Counts) == 0 {
+		return ErrNoThroughputHistory
+	}
+	for _, c := range confidences {
+		if c <= 0 || c > 1 {
+			return errors.Join(ErrConfidenceOutOfRange, fmt.Errorf("confidence (%v)", c))
+		}
+	}
+	return nil
+}
+
+func (mc *MonteCarlo) trials() int {
+	if mc.Trials <= 0 {
+		return TrialsDefault
+	}
+	return mc.Trials
+}
+
+func (mc *MonteCarlo) rand() *rand.Rand {
+	return rand.New(rand.NewPCG(mc.Seed, mc.Seed))
+}
+
+// nearestRankIndex returns the index of the nearest-rank percentile `p` (0-1) in a sorted slice of length n.
+func nearestRankIndex(n int, p float64) int {
+	rank := int(math.Ceil(p*float64(n) - 1e-9)) // tolerate float error, e.g. 1-0.85
+	return min(max(rank-1, 0), n-1)
+}
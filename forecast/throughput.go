@@ -0,0 +1,114 @@
+// Package forecast provides throughput-based Monte Carlo delivery forecasting for Jira issues.
+package forecast
+
+import (
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/grokify/mogo/time/timeutil"
+
+	"github.com/grokify/gojira/rest"
+)
+
+var ErrNoThroughputHistory = errors.New("no complete throughput intervals")
+
+// Throughput is the number of items completed in each consecutive interval starting at `Start`.
+type Throughput struct {
+	Interval timeutil.Interval `json:"interval"`
+	Start    time.Time         `json:"start"`
+	Counts   []int             `json:"counts"`
+}
+
+// NewThroughput counts completion times in each complete day or week from `start` (truncated
+// to the UTC day) to `end`, exclusive. A trailing partial interval is not counted because it
+// would understate throughput.
+func NewThroughput(times []time.Time, start, end time.Time, interval timeutil.Interval) (*Throughput, error) {
+	days, err := intervalDays(interval)
+	if err != nil {
+		return nil, err
+	}
+	start = start.UTC()
+	start = time.Date(start.Year(), start.Month(), start.Day(), 0, 0, 0, 0, time.UTC)
+	n := 0
+	for start.AddDate(0, 0, (n+1)*days).Compare(end) <= 0 {
+		n++
+	}
+	if n == 0 {
+		return nil, ErrNoThroughputHistory
+	}
+	tp := &Throughput{Interval: interval, Start: start, Counts: make([]int, n)}
+	for _, t := range times {
+		if t.IsZero() || t.Before(start) {
+			continue
+		}
+		if i := int(t.Sub(start).Hours()/24) / days; i < n {
+			tp.Counts[i]++
+		}
+	}
+	return tp, nil
+}
+
+// ThroughputFromIssuesSet returns the throughput of issues resolved between `start` and `end`,
+// using `IssueMore.ResolutionTime`. Unresolved issues are ignored.
+func ThroughputFromIssuesSet(set *rest.IssuesSet, start, end time.Time, interval timeutil.Interval) (*Throughput, error) {
+	if set == nil {
+		return nil, rest.ErrIssuesSetCannotBeNil
+	}
+	var times []time.Time
+	for _, iss := range set.Items {
+		im := rest.NewIssueMore(&iss)
+		if rt := im.ResolutionTime(); !rt.IsZero() {
+			times = append(times, rt)
+		}
+	}
+	return NewThroughput(times, start, end, interval)
+}
+
+// Total returns the number of items completed in all intervals.
+func (tp *Throughput) Total() int {
+	total := 0
+	for _, c := range tp.Counts {
+		total += c
+	}
+	return total
+}
+
+// Mean returns the mean number of items completed per interval.
+func (tp *Throughput) Mean() float64 {
+	if len(tp.Counts) == 0 {
+		return 0
+	}
+	return float64(tp.Total()) / float64(len(tp.Counts))
+}
+
+// End returns the end of the last interval.
+func (tp *Throughput) End() time.Time {
+	return tp.AddPeriods(tp.Start, len(tp.Counts))
+}
+
+// AddPeriods returns `t` plus `n` intervals.
+func (tp *Throughput) AddPeriods(t time.Time, n int) time.Time {
+	days, _ := intervalDays(tp.Interval)
+	return t.AddDate(0, 0, n*days)
+}
+
+// PeriodsUntil returns the number of complete intervals from `from` to `until`.
+func (tp *Throughput) PeriodsUntil(from, until time.Time) int {
+	n := 0
+	for !tp.AddPeriods(from, n+1).After(until) {
+		n++
+	}
+	return n
+}
+
+func intervalDays(interval timeutil.Interval) (int, error) {
+	switch interval {
+	case timeutil.IntervalDay:
+		return 1, nil
+	case timeutil.IntervalWeek:
+		return 7, nil
+	default:
+		return 0, fmt.Errorf("throughput interval not supported (%s)", interval.String())
+	}
+}
@@ -6,63 +6,82 @@ import (
 	"strings"
 	"time"
 
+	"github.com/grokify/mogo/type/maputil"
 	"github.com/grokify/mogo/type/slicesutil"
 	"github.com/grokify/mogo/type/stringsutil"
-	"github.com/grokify/mogo/type/stringsutil/join"
 )
 
 type JQLMeta struct {
-	Name            string
-	Key             string
-	Description     string
-	FilterID        int
-	QueryTime       time.Time
-	QueryTotalCount int
-	Directions      []string
+	Name            string    `yaml:"name,omitempty"`
+	Key             string    `yaml:"key,omitempty"`
+	Description     string    `yaml:"description,omitempty"`
+	FilterID        int       `yaml:"filterID,omitempty"`
+	QueryTime       time.Time `yaml:"queryTime,omitempty"`
+	QueryTotalCount int       `yaml:"queryTotalCount,omitempty"`
+	Directions      []string  `yaml:"directions,omitempty"`
 }
 
 // JQL is a JQL builder. It will create a JQL string using `JQL.String()` from the supplied infomration.
+// Values written as calls of built-in Jira functions, such as `currentUser()` or `membersOf("team-a")`,
+// are not quoted; see `JQLFunc`. Other values, such as `Blocked (External)`, are quoted. A `JQL` can be saved as YAML with `JQL.WriteFileYAML` and read with `ReadFileJQL`.
 type JQL struct {
-	Meta            JQLMeta // Not part of JQL
-	CreatedGT       *time.Time
-	CreatedGTE      *time.Time
-	CreatedLT       *time.Time
-	CreatedLTE      *time.Time
-	DueGT           *time.Time
-	DueGTE          *time.Time
-	DueLT           *time.Time
-	DueLTE          *time.Time
-	UpdatedGT       *time.Time
-	UpdatedGTE      *time.Time
-	UpdatedLT       *time.Time
-	UpdatedLTE      *time.Time
-	FiltersIncl     [][]string // outer level is `AND`, inner level is `IN`.
-	FiltersExcl     [][]string
-	IssuesIncl      [][]string
-	IssuesExcl      [][]string
-	KeysIncl        [][]string
-	KeysExcl        [][]string
-	LabelsIncl      [][]string
-	LabelsExcl      [][]string
-	ParentsIncl     [][]string
-	ParentsExcl     [][]string
-	ProjectsIncl    [][]string
-	ProjectsExcl    [][]string
-	ResolutionIncl  [][]string
-	ResolutionExcl  [][]string
-	StatusesIncl    [][]string
-	StatusesExcl    [][]string
-	TypesIncl       [][]string
-	TypesExcl       [][]string
-	SummaryLike     []string
-	SummaryNotLike  []string
-	TextLike        []string
-	TextNotLike     []string
-	CustomFieldIncl map[string][]string // slice is `IN`
-	CustomFieldExcl map[string][]string
-	AnyIncl         JQLAndOrStringer
-	AnyExcl         JQLAndOrStringer
-	Raw             []string
+	Meta            JQLMeta             `yaml:"meta,omitempty"` // Not part of JQL
+	CreatedGT       *time.Time          `yaml:"createdGT,omitempty"`
+	CreatedGTE      *time.Time          `yaml:"createdGTE,omitempty"`
+	CreatedLT       *time.Time          `yaml:"createdLT,omitempty"`
+	CreatedLTE      *time.Time          `yaml:"createdLTE,omitempty"`
+	DueGT           *time.Time          `yaml:"dueGT,omitempty"`
+	DueGTE          *time.Time          `yaml:"dueGTE,omitempty"`
+	DueLT           *time.Time          `yaml:"dueLT,omitempty"`
+	DueLTE          *time.Time          `yaml:"dueLTE,omitempty"`
+	UpdatedGT       *time.Time          `yaml:"updatedGT,omitempty"`
+	UpdatedGTE      *time.Time          `yaml:"updatedGTE,omitempty"`
+	UpdatedLT       *time.Time          `yaml:"updatedLT,omitempty"`
+	UpdatedLTE      *time.Time          `yaml:"updatedLTE,omitempty"`
+	Dates           []JQLDate           `yaml:"dates,omitempty"` // relative dates and date functions, e.g. `updated >= startOfDay(-7)`
+	AssigneesIncl   [][]string          `yaml:"assigneesIncl,omitempty"`
+	AssigneesExcl   [][]string          `yaml:"assigneesExcl,omitempty"`
+	ComponentsIncl  [][]string          `yaml:"componentsIncl,omitempty"`
+	ComponentsExcl  [][]string          `yaml:"componentsExcl,omitempty"`
+	FiltersIncl     [][]string          `yaml:"filtersIncl,omitempty"` // outer level is `AND`, inner level is `IN`.
+	FiltersExcl     [][]string          `yaml:"filtersExcl,omitempty"`
+	FixVersionsIncl [][]string          `yaml:"fixVersionsIncl,omitempty"`
+	FixVersionsExcl [][]string          `yaml:"fixVersionsExcl,omitempty"`
+	IssuesIncl      [][]string          `yaml:"issuesIncl,omitempty"`
+	IssuesExcl      [][]string          `yaml:"issuesExcl,omitempty"`
+	KeysIncl        [][]string          `yaml:"keysIncl,omitempty"`
+	KeysExcl        [][]string          `yaml:"keysExcl,omitempty"`
+	LabelsIncl      [][]string          `yaml:"labelsIncl,omitempty"`
+	LabelsExcl      [][]string          `yaml:"labelsExcl,omitempty"`
+	ParentsIncl     [][]string          `yaml:"parentsIncl,omitempty"`
+	ParentsExcl     [][]string          `yaml:"parentsExcl,omitempty"`
+	PrioritiesIncl  [][]string          `yaml:"prioritiesIncl,omitempty"`
+	PrioritiesExcl  [][]string          `yaml:"prioritiesExcl,omitempty"`
+	ProjectsIncl    [][]string          `yaml:"projectsIncl,omitempty"`
+	ProjectsExcl    [][]string          `yaml:"projectsExcl,omitempty"`
+	ReportersIncl   [][]string          `yaml:"reportersIncl,omitempty"`
+	ReportersExcl   [][]string          `yaml:"reportersExcl,omitempty"`
+	ResolutionIncl  [][]string          `yaml:"resolutionIncl,omitempty"`
+	ResolutionExcl  [][]string          `yaml:"resolutionExcl,omitempty"`
+	SprintsIncl     [][]string          `yaml:"sprintsIncl,omitempty"`
+	SprintsExcl     [][]string          `yaml:"sprintsExcl,omitempty"`
+	StatusesIncl    [][]string          `yaml:"statusesIncl,omitempty"`
+	StatusesExcl    [][]string          `yaml:"statusesExcl,omitempty"`
+	TypesIncl       [][]string          `yaml:"typesIncl,omitempty"`
+	TypesExcl       [][]string          `yaml:"typesExcl,omitempty"`
+	WatchersIncl    [][]string          `yaml:"watchersIncl,omitempty"`
+	WatchersExcl    [][]string          `yaml:"watchersExcl,omitempty"`
+	SummaryLike     []string            `yaml:"summaryLike,omitempty"`
+	SummaryNotLike  []string            `yaml:"summaryNotLike,omitempty"`
+	TextLike        []string            `yaml:"textLike,omitempty"`
+	TextNotLike     []string            `yaml:"textNotLike,omitempty"`
+	CustomFieldIncl map[string][]string `yaml:"customFieldIncl,omitempty"` // slice is `IN`
+	CustomFieldExcl map[string][]string `yaml:"customFieldExcl,omitempty"`
+	History         []JQLHistory        `yaml:"history,omitempty"`
+	AnyIncl         JQLAndOrStringer    `yaml:"-"`
+	AnyExcl         JQLAndOrStringer    `yaml:"-"`
+	Raw             []string            `yaml:"raw,omitempty"`
+	OrderBy         []JQLOrder          `yaml:"orderBy,omitempty"`
 }
 
 type JQLAndOrStringer [][]fmt.Stringer
@@ -115,6 +134,11 @@ func addPrefixSuffix(prefix, suffix, s string) string {
 }
 
 func (j JQL) String() string {
+	return strings.TrimSpace(j.StringConditions() + " " + j.stringOrderBy())
+}
+
+// StringConditions returns the JQL without `ORDER BY`, for combining with other conditions.
+func (j JQL) StringConditions() string {
 	conditions := slicesutil.AppendBulk(
 		[]string{},
 		[][]string{
@@ -122,6 +146,7 @@ func (j JQL) String() string {
 			j.conditionsDateFields(),
 			j.conditionsLikeNotLike(),
 			j.conditionsCustomFields(),
+			j.conditionsHistory(),
 			j.AnyIncl.Fields(false),
 			j.AnyExcl.Fields(true),
 			j.Raw,
@@ -131,6 +156,19 @@ func (j JQL) String() string {
 	return strings.TrimSpace(strings.Join(conditions, operatorANDSpaces))
 }
 
+func (j JQL) stringOrderBy() string {
+	var fields []string
+	for _, o := range j.OrderBy {
+		if f := o.String(); f != "" {
+			fields = append(fields, f)
+		}
+	}
+	if len(fields) == 0 {
+		return ""
+	}
+	return JQLKeywordOrder + " " + JQLKeywordBy + " " + strings.Join(fields, JQLInSep+" ")
+}
+
 func (j JQL) conditionsStringFields() []string {
 	var conditions []string
 
@@ -140,8 +178,14 @@ func (j JQL) conditionsStringFields() []string {
 		Exclude bool
 	}
 	procs := []inclExclProc{
+		{Field: FieldAssignee, Values: j.AssigneesIncl, Exclude: false},
+		{Field: FieldAssignee, Values: j.AssigneesExcl, Exclude: true},
+		{Field: FieldComponent, Values: j.ComponentsIncl, Exclude: false},
+		{Field: FieldComponent, Values: j.ComponentsExcl, Exclude: true},
 		{Field: FieldFilter, Values: j.FiltersIncl, Exclude: false},
 		{Field: FieldFilter, Values: j.FiltersExcl, Exclude: true},
+		{Field: FieldFixVersion, Values: j.FixVersionsIncl, Exclude: false},
+		{Field: FieldFixVersion, Values: j.FixVersionsExcl, Exclude: true},
 		{Field: FieldIssue, Values: j.IssuesIncl, Exclude: false},
 		{Field: FieldIssue, Values: j.IssuesExcl, Exclude: true},
 		{Field: FieldKey, Values: j.KeysIncl, Exclude: false},
@@ -150,14 +194,22 @@ func (j JQL) conditionsStringFields() []string {
 		{Field: FieldLabels, Values: j.LabelsExcl, Exclude: true},
 		{Field: FieldParent, Values: j.ParentsIncl, Exclude: false},
 		{Field: FieldParent, Values: j.ParentsExcl, Exclude: true},
+		{Field: FieldPriority, Values: j.PrioritiesIncl, Exclude: false},
+		{Field: FieldPriority, Values: j.PrioritiesExcl, Exclude: true},
 		{Field: FieldProject, Values: j.ProjectsIncl, Exclude: false},
 		{Field: FieldProject, Values: j.ProjectsExcl, Exclude: true},
+		{Field: FieldReporter, Values: j.ReportersIncl, Exclude: false},
+		{Field: FieldReporter, Values: j.ReportersExcl, Exclude: true},
 		{Field: FieldResolution, Values: j.ResolutionIncl, Exclude: false},
 		{Field: FieldResolution, Values: j.ResolutionExcl, Exclude: true},
+		{Field: FieldSprint, Values: j.SprintsIncl, Exclude: false},
+		{Field: FieldSprint, Values: j.SprintsExcl, Exclude: true},
 		{Field: FieldStatus, Values: j.StatusesIncl, Exclude: false},
 		{Field: FieldStatus, Values: j.StatusesExcl, Exclude: true},
 		{Field: FieldType, Values: j.TypesIncl, Exclude: false},
 		{Field: FieldType, Values: j.TypesExcl, Exclude: true},
+		{Field: FieldWatcher, Values: j.WatchersIncl, Exclude: false},
+		{Field: FieldWatcher, Values: j.WatchersExcl, Exclude: true},
 	}
 	for _, proc := range procs {
 		if field := strings.TrimSpace(proc.Field); field == "" {
@@ -206,6 +258,11 @@ func (j JQL) conditionsDateFields() []string {
 			)
 		}
 	}
+	for _, d := range j.Dates {
+		if cond := d.String(); cond != "" {
+			conditions = append(conditions, cond)
+		}
+	}
 	return conditions
 }
 
@@ -229,8 +286,8 @@ func (j JQL) conditionsLikeNotLike() []string {
 
 func (j JQL) conditionsCustomFields() []string {
 	var conditions []string
-	for cfk, cfv := range j.CustomFieldIncl {
-		cfv = stringsutil.SliceCondenseSpace(cfv, true, false)
+	for _, cfk := range maputil.Keys(j.CustomFieldIncl) {
+		cfv := stringsutil.SliceCondenseSpace(j.CustomFieldIncl[cfk], true, false)
 		if len(cfv) == 0 {
 			continue
 		}
@@ -241,8 +298,8 @@ func (j JQL) conditionsCustomFields() []string {
 			conditions = append(conditions, cond)
 		}
 	}
-	for cfk, cfv := range j.CustomFieldExcl {
-		cfv = stringsutil.SliceCondenseSpace(cfv, true, false)
+	for _, cfk := range maputil.Keys(j.CustomFieldExcl) {
+		cfv := stringsutil.SliceCondenseSpace(j.CustomFieldExcl[cfk], true, false)
 		if len(cfv) == 0 {
 			continue
 		}
@@ -256,14 +313,27 @@ func (j JQL) conditionsCustomFields() []string {
 	return conditions
 }
 
+func (j JQL) conditionsHistory() []string {
+	var conditions []string
+	for _, h := range j.History {
+		if cond := h.String(); cond != "" {
+			conditions = append(conditions, cond)
+		}
+	}
+	return conditions
+}
+
 func (j JQL) QueryString() string {
 	return "jql=" + url.QueryEscape(j.String())
 }
 
-// quoteFieldIfNeeded wraps field names containing spaces in double quotes for JQL.
+// quoteFieldIfNeeded wraps field names that cannot be written unquoted, such as names containing
+// spaces or reserved words, in double quotes for JQL. Names that are already quoted are unchanged.
 func quoteFieldIfNeeded(field string) string {
-	if strings.Contains(field, " ") {
-		return `"` + field + `"`
+	if len(field) > 1 && strings.HasPrefix(field, `"`) && strings.HasSuffix(field, `"`) {
+		return field
+	} else if jqlNeedsQuotes(field) {
+		return jqlQuote(field)
 	}
 	return field
 }
@@ -275,23 +345,28 @@ func inCondition(field string, values []string, exclude bool) string {
 		return ""
 	}
 	field = quoteFieldIfNeeded(field)
-	if len(values) == 1 {
+	if len(values) == 1 && !jqlIsListFunc(values[0]) {
 		operator := "="
 		if exclude {
 			operator = "!="
 		}
-		qtr := stringsutil.Quoter{
-			Beg:         "'",
-			End:         "'",
-			SkipNesting: true,
+		return fmt.Sprintf("%s %s %s", field, operator, jqlBuilderValue(values[0]))
+	} else if len(values) == 1 {
+		operator := "IN"
+		if exclude {
+			operator = "NOT IN"
 		}
-		return fmt.Sprintf("%s %s %s", field, operator, qtr.Quote(values[0]))
+		return fmt.Sprintf("%s %s %s", field, operator, values[0])
 	} else if len(values) > 1 {
 		operator := "IN"
 		if exclude {
 			operator = "NOT IN"
 		}
-		return fmt.Sprintf("%s %s (%s)", field, operator, join.JoinQuote(values, "'", "'", JQLInSep))
+		var quoted []string
+		for _, v := range values {
+			quoted = append(quoted, jqlBuilderValue(v))
+		}
+		return fmt.Sprintf("%s %s (%s)", field, operator, strings.Join(quoted, JQLInSep))
 	} else {
 		return ""
 	}
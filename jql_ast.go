@@ -0,0 +1,283 @@
+package gojira
+
+import (
+	"strings"
+)
+
+// JQLQuery is a parsed JQL query. `Where` is nil if the query has no clauses, e.g. `ORDER BY created`.
+type JQLQuery struct {
+	Where   JQLExpr
+	OrderBy []JQLOrderBy
+}
+
+// JQLOrderBy is a field in an `ORDER BY` list. Direction is `ASC`, `DESC` or empty for the field default.
+type JQLOrderBy struct {
+	Field     JQLField
+	Direction string
+	Pos       JQLPos
+}
+
+// JQLPos is a position in a JQL string. Offset is in bytes from 0. Line and Column start at 1,
+// and Column counts runes.
+type JQLPos struct {
+	Offset int `json:"offset"`
+	Line   int `json:"line"`
+	Column int `json:"column"`
+}
+
+// JQLExpr is a node in a JQL condition: `*JQLAnd`, `*JQLOr`, `*JQLNot` or `*JQLClause`.
+type JQLExpr interface {
+	Position() JQLPos
+	String() string
+	jqlExpr()
+}
+
+// JQLAnd is two or more conditions joined by `AND`.
+type JQLAnd struct {
+	Exprs []JQLExpr
+	Pos   JQLPos
+}
+
+// JQLOr is two or more conditions joined by `OR`.
+type JQLOr struct {
+	Exprs []JQLExpr
+	Pos   JQLPos
+}
+
+// JQLNot is a negated condition.
+type JQLNot struct {
+	Expr JQLExpr
+	Pos  JQLPos
+}
+
+// JQLClause is a condition on a field, e.g. `status IN (Open, "In Progress")` or
+// `status CHANGED FROM Open AFTER -1w`. Value is nil for `CHANGED`.
+type JQLClause struct {
+	Field      JQLField
+	Operator   string // e.g. `OperatorEQ`, `OperatorNotIn`, `OperatorWasNot`, `OperatorChanged`
+	Value      JQLValue
+	Predicates []JQLPredicate // history predicates for `WAS` and `CHANGED`
+	Pos        JQLPos
+}
+
+// JQLField is a field name as written, without quotes, e.g. `status`, `Story Points` or `cf[10010]`.
+type JQLField struct {
+	Name string
+	Pos  JQLPos
+}
+
+// JQLPredicate is a history predicate, e.g. `AFTER "2024-01-01"` or `BY currentUser()`.
+type JQLPredicate struct {
+	Name  string // e.g. `JQLPredicateAfter`
+	Value JQLValue
+	Pos   JQLPos
+}
+
+// JQL history predicate names.
+const (
+	JQLPredicateAfter  = "AFTER"
+	JQLPredicateBefore = "BEFORE"
+	JQLPredicateBy     = "BY"
+	JQLPredicateDuring = "DURING"
+	JQLPredicateFrom   = "FROM"
+	JQLPredicateOn     = "ON"
+	JQLPredicateTo     = "TO"
+)
+
+// JQLValue is an operand: `*JQLLiteral`, `*JQLFunction` or `*JQLList`.
+type JQLValue interface {
+	Position() JQLPos
+	String() string
+	jqlValue()
+}
+
+// JQLLiteral is a quoted or unquoted value, or `EMPTY`.
+type JQLLiteral struct {
+	Value  string // unquoted and unescaped; `EMPTY` if Empty
+	Quoted bool
+	Empty  bool // `EMPTY` or `NULL`
+	Pos    JQLPos
+}
+
+// JQLFunction is a function call, e.g. `currentUser()` or `membersOf("team-a")`.
+type JQLFunction struct {
+	Name string
+	Args []*JQLLiteral
+	Pos  JQLPos
+}
+
+// JQLList is a parenthesized list of values, e.g. `(Open, "In Progress")`.
+type JQLList struct {
+	Values []JQLValue
+	Pos    JQLPos
+}
+
+func (*JQLAnd) jqlExpr()    {}
+func (*JQLOr) jqlExpr()     {}
+func (*JQLNot) jqlExpr()    {}
+func (*JQLClause) jqlExpr() {}
+
+func (*JQLLiteral) jqlValue()  {}
+func (*JQLFunction) jqlValue() {}
+func (*JQLList) jqlValue()     {}
+
+func (e *JQLAnd) Position() JQLPos      { return e.Pos }
+func (e *JQLOr) Position() JQLPos       { return e.Pos }
+func (e *JQLNot) Position() JQLPos      { return e.Pos }
+func (e *JQLClause) Position() JQLPos   { return e.Pos }
+func (v *JQLLiteral) Position() JQLPos  { return v.Pos }
+func (v *JQLFunction) Position() JQLPos { return v.Pos }
+func (v *JQLList) Position() JQLPos     { return v.Pos }
+
+// String returns the query as canonical JQL: keywords and operators are upper case, single spaces
+// separate tokens, strings are double quoted and parentheses are kept only where they group.
+func (q *JQLQuery) String() string {
+	var parts []string
+	if q.Where != nil {
+		parts = append(parts, q.Where.String())
+	}
+	if len(q.OrderBy) > 0 {
+		var fields []string
+		for _, o := range q.OrderBy {
+			fields = append(fields, strings.TrimSpace(o.Field.String()+" "+o.Direction))
+		}
+		parts = append(parts, "ORDER BY "+strings.Join(fields, ", "))
+	}
+	return strings.Join(parts, " ")
+}
+
+func (e *JQLAnd) String() string {
+	var parts []string
+	for _, x := range e.Exprs {
+		switch x.(type) {
+		case *JQLAnd, *JQLOr:
+			parts = append(parts, addParen(x.String()))
+		default:
+			parts = append(parts, x.String())
+		}
+	}
+	return strings.Join(parts, operatorANDSpaces)
+}
+
+func (e *JQLOr) String() string {
+	var parts []string
+	for _, x := range e.Exprs {
+		if _, ok := x.(*JQLOr); ok {
+			parts = append(parts, addParen(x.String()))
+		} else {
+			parts = append(parts, x.String())
+		}
+	}
+	return strings.Join(parts, operatorORSpaces)
+}
+
+func (e *JQLNot) String() string {
+	switch e.Expr.(type) {
+	case *JQLAnd, *JQLOr:
+		return "NOT " + addParen(e.Expr.String())
+	default:
+		return "NOT " + e.Expr.String()
+	}
+}
+
+func (e *JQLClause) String() string {
+	parts := []string{e.Field.String(), e.Operator}
+	if e.Value != nil {
+		parts = append(parts, e.Value.String())
+	}
+	for _, p := range e.Predicates {
+		parts = append(parts, p.String())
+	}
+	return strings.Join(parts, " ")
+}
+
+// String returns the field name, quoted if it is not a valid unquoted name.
+func (f JQLField) String() string {
+	if jqlNeedsQuotes(f.Name) {
+		return jqlQuote(f.Name)
+	}
+	return f.Name
+}
+
+func (p JQLPredicate) String() string {
+	if p.Value == nil {
+		return p.Name
+	}
+	return p.Name + " " + p.Value.String()
+}
+
+func (v *JQLLiteral) String() string {
+	if v.Empty {
+		return JQLKeywordEmpty
+	} else if v.Quoted || jqlNeedsQuotes(v.Value) {
+		return jqlQuote(v.Value)
+	}
+	return v.Value
+}
+
+func (v *JQLFunction) String() string {
+	var args []string
+	for _, a := range v.Args {
+		args = append(args, a.String())
+	}
+	return v.Name + addParen(strings.Join(args, ", "))
+}
+
+func (v *JQLList) String() string {
+	var vals []string
+	for _, x := range v.Values {
+		vals = append(vals, x.String())
+	}
+	return addParen(strings.Join(vals, ", "))
+}
+
+// Clauses returns the clauses of the query in order.
+func (q *JQLQuery) Clauses() []*JQLClause {
+	var out []*JQLClause
+	JQLWalk(q.Where, func(e JQLExpr) bool {
+		if c, ok := e.(*JQLClause); ok {
+			out = append(out, c)
+		}
+		return true
+	})
+	return out
+}
+
+// JQLWalk calls fn for an expression and its descendants, depth first. If fn returns false, the
+// descendants of that expression are skipped.
+func JQLWalk(e JQLExpr, fn func(JQLExpr) bool) {
+	if e == nil || !fn(e) {
+		return
+	}
+	switch x := e.(type) {
+	case *JQLAnd:
+		for _, c := range x.Exprs {
+			JQLWalk(c, fn)
+		}
+	case *JQLOr:
+		for _, c := range x.Exprs {
+			JQLWalk(c, fn)
+		}
+	case *JQLNot:
+		JQLWalk(x.Expr, fn)
+	}
+}
+
+// jqlQuote returns a double quoted JQL string.
+func jqlQuote(s string) string {
+	return `"` + strings.NewReplacer(`\`, `\\`, `"`, `\"`, "\n", `\n`, "\t", `\t`).Replace(s) + `"`
+}
+
+// jqlNeedsQuotes reports if a value or field name cannot be written unquoted: it is empty, is a
+// reserved word or contains a reserved character.
+func jqlNeedsQuotes(s string) bool {
+	if s == "" || jqlIsReservedWord(s) {
+		return true
+	}
+	for _, r := range s {
+		if !jqlIsWordRune(r) {
+			return true
+		}
+	}
+	return false
+}
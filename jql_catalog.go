@@ -0,0 +1,243 @@
+package gojira
+
+import (
+	"errors"
+	"fmt"
+	"os"
+	"regexp"
+	"slices"
+	"sort"
+	"strings"
+
+	"gopkg.in/yaml.v3"
+)
+
+// JQLCatalog is a set of named queries, usually read from a YAML file with `ReadFileJQLCatalog`, so
+// standard queries can be versioned and shared. Queries can have `{{name}}` parameters. Values are
+// escaped inside quoted strings and otherwise quoted unless they are a single word, number or
+// function call, so a value cannot add clauses. For example:
+//
+//	title: Team FOO Queries
+//	params:
+//	  project: FOO
+//	queries:
+//	  - name: Open bugs
+//	    key: open-bugs
+//	    description: Unresolved bugs, highest priority first
+//	    directions:
+//	      - Triage new bugs daily.
+//	    jql: project = {{project}} AND type = Bug AND resolution IS EMPTY ORDER BY priority DESC
+//	  - name: My sprint work
+//	    query:
+//	      projectsIncl: [["{{project}}"]]
+//	      assigneesIncl: [[currentUser()]]
+//	      sprintsIncl: [[openSprints()]]
+type JQLCatalog struct {
+	Title   string            `yaml:"title,omitempty"`
+	Params  map[string]string `yaml:"params,omitempty"` // default parameter values for all queries
+	Queries []JQLCatalogQuery `yaml:"queries"`
+}
+
+// JQLCatalogQuery is a named query with either a JQL string or a `JQL` builder. Name, Key,
+// Description, Directions and FilterID are copied to `JQLMeta`.
+type JQLCatalogQuery struct {
+	Name        string            `yaml:"name"`
+	Key         string            `yaml:"key,omitempty"`
+	Description string            `yaml:"description,omitempty"`
+	Directions  []string          `yaml:"directions,omitempty"`
+	FilterID    int               `yaml:"filterID,omitempty"`
+	Params      map[string]string `yaml:"params,omitempty"` // default parameter values, overriding the catalog's
+	JQL         string            `yaml:"jql,omitempty"`
+	Query       *JQL              `yaml:"query,omitempty"`
+}
+
+var rxJQLCatalogParam = regexp.MustCompile(`\{\{\s*([A-Za-z0-9_.-]+)\s*\}\}`)
+
+// ReadFileJQLCatalog reads a `JQLCatalog` from a YAML file and checks it with `JQLCatalog.Validate`.
+func ReadFileJQLCatalog(filename string) (*JQLCatalog, error) {
+	b, err := os.ReadFile(filename)
+	if err != nil {
+		return nil, err
+	}
+	cat := &JQLCatalog{}
+	if err := yaml.Unmarshal(b, cat); err != nil {
+		return nil, fmt.Errorf("invalid query catalog file (%s): %w", filename, err)
+	} else if err := cat.Validate(); err != nil {
+		return nil, fmt.Errorf("invalid query catalog file (%s): %w", filename, err)
+	}
+	return cat, nil
+}
+
+// Validate checks that each query has a name, a key and name that are not used by another query,
+// and either a JQL string or a `JQL` builder.
+func (cat *JQLCatalog) Validate() error {
+	if len(cat.Queries) == 0 {
+		return errors.New("query catalog has no queries")
+	}
+	seen := map[string]bool{}
+	for i, q := range cat.Queries {
+		name := strings.TrimSpace(q.Name)
+		if name == "" {
+			return fmt.Errorf("query catalog query #%d has no name", i+1)
+		} else if (strings.TrimSpace(q.JQL) == "") == (q.Query == nil) {
+			return fmt.Errorf("query catalog query must have one of jql or query (%s)", name)
+		}
+		for _, id := range []string{name, strings.TrimSpace(q.Key)} {
+			if id == "" {
+				continue
+			} else if seen[strings.ToLower(id)] {
+				return fmt.Errorf("query catalog name or key is duplicated (%s)", id)
+			}
+			seen[strings.ToLower(id)] = true
+		}
+	}
+	return nil
+}
+
+// Query returns the query with a name or key, case-insensitive.
+func (cat *JQLCatalog) Query(nameOrKey string) (JQLCatalogQuery, error) {
+	nameOrKey = strings.TrimSpace(nameOrKey)
+	for _, q := range cat.Queries {
+		if strings.EqualFold(strings.TrimSpace(q.Name), nameOrKey) || strings.EqualFold(strings.TrimSpace(q.Key), nameOrKey) {
+			return q, nil
+		}
+	}
+	return JQLCatalogQuery{}, fmt.Errorf("query not found in catalog (%s)", nameOrKey)
+}
+
+// JQL returns the query with a name or key, with parameters replaced. See `JQLCatalog.JQLs`.
+func (cat *JQLCatalog) JQL(nameOrKey string, params map[string]string) (JQL, error) {
+	q, err := cat.Query(nameOrKey)
+	if err != nil {
+		return JQL{}, err
+	}
+	return q.JQLWithParams(cat.Params, params)
+}
+
+// JQLs returns all queries in order with parameters replaced. params override the query's and the
+// catalog's default values. It returns an error naming any parameters without a value.
+func (cat *JQLCatalog) JQLs(params map[string]string) (JQLs, error) {
+	var out JQLs
+	var errs []error
+	for _, q := range cat.Queries {
+		j, err := q.JQLWithParams(cat.Params, params)
+		if err != nil {
+			errs = append(errs, err)
+		}
+		out = append(out, j)
+	}
+	return out, errors.Join(errs...)
+}
+
+// ParamNames returns the sorted names of the parameters used by the query.
+func (q JQLCatalogQuery) ParamNames() []string {
+	seen := map[string]bool{}
+	var names []string
+	for _, m := range rxJQLCatalogParam.FindAllStringSubmatch(q.template(), -1) {
+		if !seen[m[1]] {
+			seen[m[1]] = true
+			names = append(names, m[1])
+		}
+	}
+	sort.Strings(names)
+	return names
+}
+
+// JQLWithParams returns the query as a `JQL` whose `Raw` is the query string with parameters
+// replaced. Values in params override the query's defaults, which override catalogParams.
+func (q JQLCatalogQuery) JQLWithParams(catalogParams, params map[string]string) (JQL, error) {
+	values := map[string]string{}
+	for _, m := range []map[string]string{catalogParams, q.Params, params} {
+		for k, v := range m {
+			values[strings.TrimSpace(k)] = v
+		}
+	}
+	var missing []string
+	var sb strings.Builder
+	var quote rune
+	tmpl, last := q.template(), 0
+	for _, loc := range rxJQLCatalogParam.FindAllStringSubmatchIndex(tmpl, -1) {
+		quote = jqlQuoteState(tmpl[last:loc[0]], quote)
+		sb.WriteString(tmpl[last:loc[0]])
+		name := tmpl[loc[2]:loc[3]]
+		if v, ok := values[name]; ok {
+			sb.WriteString(jqlCatalogParamValue(v, quote))
+		} else {
+			missing = append(missing, name)
+		}
+		last = loc[1]
+	}
+	sb.WriteString(tmpl[last:])
+	s := sb.String()
+	j := JQL{
+		Meta: JQLMeta{
+			Name:        strings.TrimSpace(q.Name),
+			Key:         strings.TrimSpace(q.Key),
+			Description: strings.TrimSpace(q.Description),
+			FilterID:    q.FilterID,
+			Directions:  q.Directions},
+		Raw: []string{strings.TrimSpace(s)}}
+	if len(missing) > 0 {
+		sort.Strings(missing)
+		missing = slices.Compact(missing)
+		return j, fmt.Errorf("query (%s) parameters have no value (%s)", j.Meta.Name, strings.Join(missing, ", "))
+	}
+	return j, nil
+}
+
+// jqlCatalogParamValue returns a parameter value to insert in a query. Inside a string quoted with
+// quote, the value is escaped. Otherwise, it is double-quoted unless it is a single word, number or
+// built-in Jira function call, so `Blocked (External)` is quoted.
+func jqlCatalogParamValue(v string, quote rune) string {
+	if quote != 0 {
+		return strings.NewReplacer(`\`, `\\`, string(quote), `\`+string(quote), "\n", `\n`, "\t", `\t`).Replace(v)
+	} else if v = strings.TrimSpace(v); !jqlNeedsQuotes(v) || jqlIsFuncValue(v) {
+		return v
+	}
+	return jqlQuote(v)
+}
+
+// jqlIsFuncValue reports if a value is exactly one built-in function call, such as
+// `membersOf("team-a")`.
+func jqlIsFuncValue(v string) bool {
+	if !jqlIsFunc(v) {
+		return false
+	}
+	q, err := ParseJQL("f = " + v)
+	if err != nil || len(q.OrderBy) > 0 {
+		return false
+	}
+	cl, ok := q.Where.(*JQLClause)
+	if !ok {
+		return false
+	}
+	_, ok = cl.Value.(*JQLFunction)
+	return ok
+}
+
+// jqlQuoteState returns the quote character of the string open at the end of s, or 0, given the
+// quote open at its start.
+func jqlQuoteState(s string, quote rune) rune {
+	escaped := false
+	for _, r := range s {
+		switch {
+		case escaped:
+			escaped = false
+		case quote != 0 && r == '\\':
+			escaped = true
+		case quote != 0 && r == quote:
+			quote = 0
+		case quote == 0 && (r == '"' || r == '\''):
+			quote = r
+		}
+	}
+	return quote
+}
+
+// template returns the query string before parameters are replaced.
+func (q JQLCatalogQuery) template() string {
+	if q.Query != nil {
+		return q.Query.String()
+	}
+	return strings.TrimSpace(q.JQL)
+}
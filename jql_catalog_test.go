@@ -0,0 +1,89 @@
+package gojira
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+const jqlCatalogTestYAML = `title: Team Queries
+params:
+  project: FOO
+queries:
+  - name: Open bugs
+    key: open-bugs
+    description: Unresolved bugs
+    directions: [Triage daily.]
+    jql: project = {{project}} AND type = Bug AND resolution IS EMPTY ORDER BY priority DESC
+  - name: Sprint work
+    params:
+      team: core
+    query:
+      projectsIncl: [["{{ project }}"]]
+      labelsIncl: [["{{team}}"]]
+      sprintsIncl: [[openSprints()]]
+  - name: Fix version
+    jql: project = {{project}} AND fixVersion = "{{version}}"
+`
+
+func TestJQLCatalog(t *testing.T) {
+	filename := filepath.Join(t.TempDir(), "queries.yaml")
+	if err := os.WriteFile(filename, []byte(jqlCatalogTestYAML), 0600); err != nil {
+		t.Fatalf("os.WriteFile() error: (%s)", err.Error())
+	}
+	cat, err := ReadFileJQLCatalog(filename)
+	if err != nil {
+		t.Fatalf("gojira.ReadFileJQLCatalog() error: (%s)", err.Error())
+	}
+
+	tests := []struct {
+		nameOrKey string
+		params    map[string]string
+		want      string
+		wantErr   bool
+	}{
+		{"OPEN-BUGS", nil, "project = FOO AND type = Bug AND resolution IS EMPTY ORDER BY priority DESC", false},
+		{"sprint work", map[string]string{"project": "BAR"}, "labels = 'core' AND project = 'BAR' AND sprint IN openSprints()", false},
+		{"Fix version", map[string]string{"version": "1.0"}, `project = FOO AND fixVersion = "1.0"`, false},
+		{"Fix version", nil, "", true},
+		{"open-bugs", map[string]string{"project": "My Project"},
+			`project = "My Project" AND type = Bug AND resolution IS EMPTY ORDER BY priority DESC`, false},
+		{"open-bugs", map[string]string{"project": "FOO OR project IS NOT EMPTY"},
+			`project = "FOO OR project IS NOT EMPTY" AND type = Bug AND resolution IS EMPTY ORDER BY priority DESC`, false},
+		{"open-bugs", map[string]string{"project": `projectsLeadByUser("jdoe")`},
+			`project = projectsLeadByUser("jdoe") AND type = Bug AND resolution IS EMPTY ORDER BY priority DESC`, false},
+		{"open-bugs", map[string]string{"project": "Blocked (External)"},
+			`project = "Blocked (External)" AND type = Bug AND resolution IS EMPTY ORDER BY priority DESC`, false},
+		{"open-bugs", map[string]string{"project": `linkedIssuesOf("FOO-1")`},
+			`project = "linkedIssuesOf(\"FOO-1\")" AND type = Bug AND resolution IS EMPTY ORDER BY priority DESC`, false},
+		{"Fix version", map[string]string{"version": `2.0" OR fixVersion IS NOT EMPTY OR fixVersion = "x`},
+			`project = FOO AND fixVersion = "2.0\" OR fixVersion IS NOT EMPTY OR fixVersion = \"x"`, false},
+		{"sprint work", map[string]string{"team": "core's"}, `labels = 'core\'s' AND project = 'FOO' AND sprint IN openSprints()`, false},
+		{"Unknown", nil, "", true},
+	}
+	for _, tt := range tests {
+		j, err := cat.JQL(tt.nameOrKey, tt.params)
+		if tt.wantErr {
+			if err == nil {
+				t.Errorf("gojira.JQLCatalog.JQL(\"%s\") mismatch: want error, got (%s)", tt.nameOrKey, j.String())
+			}
+			continue
+		} else if err != nil {
+			t.Errorf("gojira.JQLCatalog.JQL(\"%s\") error: (%s)", tt.nameOrKey, err.Error())
+		} else if got := j.String(); got != tt.want {
+			t.Errorf("gojira.JQLCatalog.JQL(\"%s\") mismatch: want (%s), got (%s)", tt.nameOrKey, tt.want, got)
+		}
+	}
+
+	jqls, err := cat.JQLs(nil)
+	if err == nil || !strings.Contains(err.Error(), "(version)") || len(jqls) != 3 {
+		t.Errorf("gojira.JQLCatalog.JQLs() mismatch: want 3 queries and missing (version), got (%d, %v)", len(jqls), err)
+	}
+	if got := jqls[0].Meta.Directions; len(got) != 1 || jqls[0].Meta.Key != "open-bugs" {
+		t.Errorf("gojira.JQLCatalog.JQLs() meta mismatch: want (open-bugs), got (%s, %v)", jqls[0].Meta.Key, got)
+	}
+	if got := strings.Join(cat.Queries[1].ParamNames(), ","); got != "project,team" {
+		t.Errorf("gojira.JQLCatalogQuery.ParamNames() mismatch: want (project,team), got (%s)", got)
+	}
+}
@@ -0,0 +1,312 @@
+package gojira
+
+import (
+	"fmt"
+	"os"
+	"regexp"
+	"strings"
+
+	"github.com/grokify/mogo/type/stringsutil"
+	"gopkg.in/yaml.v3"
+)
+
+// JQL functions without arguments, for use as `JQL` values, e.g. `AssigneesIncl: [][]string{{JQLFuncCurrentUser}}`
+// or `SprintsIncl: [][]string{{JQLFuncOpenSprints}}`.
+const (
+	JQLFuncCurrentUser   = "currentUser()"
+	JQLFuncClosedSprints = "closedSprints()"
+	JQLFuncFutureSprints = "futureSprints()"
+	JQLFuncIssueHistory  = "issueHistory()"
+	JQLFuncNow           = "now()"
+	JQLFuncOpenSprints   = "openSprints()"
+)
+
+// jqlFuncs are the built-in Jira functions, by lowercase name. Functions that return one value,
+// marked true, are compared with `=`. Other functions return lists and are compared with `IN`.
+var jqlFuncs = map[string]bool{
+	"approved":                               false,
+	"approver":                               false,
+	"breached":                               false,
+	"cascadeoption":                          false,
+	"closedsprints":                          false,
+	"completed":                              false,
+	"componentsleadbyuser":                   false,
+	"currentlogin":                           true,
+	"currentuser":                            true,
+	"earliestunreleasedversion":              true,
+	"earliestunreleasedversionbyreleasedate": true,
+	"elapsed":                                false,
+	"endofday":                               true,
+	"endofmonth":                             true,
+	"endofweek":                              true,
+	"endofyear":                              true,
+	"everbreached":                           false,
+	"futuresprints":                          false,
+	"issuehistory":                           false,
+	"issueswithremotelinksbyglobalid":        false,
+	"lastlogin":                              true,
+	"latestreleasedversion":                  true,
+	"latestreleasedversionbyreleasedate":     true,
+	"linkedissues":                           false,
+	"membersof":                              false,
+	"mypending":                              false,
+	"now":                                    true,
+	"opensprints":                            false,
+	"organizationmembers":                    false,
+	"paused":                                 false,
+	"pending":                                false,
+	"pendingby":                              false,
+	"projectsleadbyuser":                     false,
+	"projectswhereuserhaspermission":         false,
+	"projectswhereuserhasrole":               false,
+	"releasedversions":                       false,
+	"remaining":                              false,
+	"running":                                false,
+	"standardissuetypes":                     false,
+	"startofday":                             true,
+	"startofmonth":                           true,
+	"startofweek":                            true,
+	"startofyear":                            true,
+	"subtaskissuetypes":                      false,
+	"unreleasedversions":                     false,
+	"updatedby":                              false,
+	"votedissues":                            false,
+	"watchedissues":                          false,
+	"withincalendarhours":                    false,
+}
+
+// rxJQLFuncCall matches a call with no space between the name and `(`, so names such as
+// `Blocked (External)` are not calls.
+var rxJQLFuncCall = regexp.MustCompile(`^([A-Za-z][A-Za-z0-9_]*)\(.*\)$`)
+
+var rxJQLNumber = regexp.MustCompile(`^[+-]?[0-9]+(\.[0-9]+)?$`)
+
+// JQLFunc returns a JQL function call with each argument double-quoted unless it is a number, e.g.
+// `JQLFunc("membersOf", "team-a")` returns `membersOf("team-a")`. `JQL` leaves the result unquoted only
+// if name is a built-in Jira function.
+func JQLFunc(name string, args ...string) string {
+	var quoted []string
+	for _, a := range args {
+		if rxJQLNumber.MatchString(a) {
+			quoted = append(quoted, a)
+		} else {
+			quoted = append(quoted, jqlQuote(a))
+		}
+	}
+	return strings.TrimSpace(name) + addParen(strings.Join(quoted, ", "))
+}
+
+// JQLMembersOf returns `membersOf(group)`, for user fields with `IN`.
+func JQLMembersOf(group string) string { return JQLFunc("membersOf", group) }
+
+// JQLStartOfDay returns `startOfDay(inc)`, e.g. `JQLStartOfDay("-7")` for the start of the day a week
+// ago. inc is optional.
+func JQLStartOfDay(inc string) string   { return jqlDateFunc("startOfDay", inc) }
+func JQLStartOfWeek(inc string) string  { return jqlDateFunc("startOfWeek", inc) }
+func JQLStartOfMonth(inc string) string { return jqlDateFunc("startOfMonth", inc) }
+func JQLStartOfYear(inc string) string  { return jqlDateFunc("startOfYear", inc) }
+func JQLEndOfDay(inc string) string     { return jqlDateFunc("endOfDay", inc) }
+func JQLEndOfWeek(inc string) string    { return jqlDateFunc("endOfWeek", inc) }
+func JQLEndOfMonth(inc string) string   { return jqlDateFunc("endOfMonth", inc) }
+func JQLEndOfYear(inc string) string    { return jqlDateFunc("endOfYear", inc) }
+
+// jqlDateFunc returns a date function call. The increment, such as `-1` or `+2w`, is not quoted.
+func jqlDateFunc(name, inc string) string {
+	return name + addParen(strings.TrimSpace(inc))
+}
+
+// jqlIsFunc reports if a value is a call of a built-in Jira function, such as `currentUser()`.
+func jqlIsFunc(v string) bool {
+	_, ok := jqlFuncName(v)
+	return ok
+}
+
+// jqlIsListFunc reports if a value is a call of a function that returns a list, such as
+// `membersOf("team-a")` or `openSprints()`.
+func jqlIsListFunc(v string) bool {
+	name, ok := jqlFuncName(v)
+	return ok && !jqlFuncs[name]
+}
+
+// jqlFuncName returns the lowercase name of a built-in Jira function called by a value.
+func jqlFuncName(v string) (string, bool) {
+	m := rxJQLFuncCall.FindStringSubmatch(strings.TrimSpace(v))
+	if m == nil {
+		return "", false
+	}
+	name := strings.ToLower(m[1])
+	_, ok := jqlFuncs[name]
+	return name, ok
+}
+
+// jqlBuilderValue returns a value in single quotes, or unquoted if it is a built-in function call.
+func jqlBuilderValue(v string) string {
+	if jqlIsFunc(v) {
+		return strings.TrimSpace(v)
+	}
+	qtr := stringsutil.Quoter{
+		Beg:         "'",
+		End:         "'",
+		SkipNesting: true,
+	}
+	return qtr.Quote(v)
+}
+
+// jqlBuilderDateValue returns a date value unquoted if it can be, e.g. `2024-01-31`, `-2w` or
+// `startOfWeek()`, and in single quotes otherwise.
+func jqlBuilderDateValue(v string) string {
+	if v = strings.TrimSpace(v); jqlIsFunc(v) || !jqlNeedsQuotes(v) {
+		return v
+	}
+	return jqlBuilderValue(v)
+}
+
+// JQLDate is a date condition with a relative date, date function or date string, e.g.
+// `JQLDate{Field: "updated", Operator: OperatorGTE, Value: JQLStartOfDay("-7")}` or
+// `JQLDate{Field: "created", Operator: OperatorGT, Value: "-2w"}`.
+type JQLDate struct {
+	Field    string `yaml:"field"`
+	Operator string `yaml:"operator"`
+	Value    string `yaml:"value"`
+}
+
+func (d JQLDate) String() string {
+	field := strings.TrimSpace(d.Field)
+	op := strings.TrimSpace(d.Operator)
+	val := strings.TrimSpace(d.Value)
+	if field == "" || op == "" || val == "" {
+		return ""
+	}
+	return fmt.Sprintf("%s %s %s", quoteFieldIfNeeded(field), op, jqlBuilderDateValue(val))
+}
+
+// JQLHistory is a `WAS` or `CHANGED` condition on a field's history, e.g.
+// `status WAS 'In Progress' DURING (startOfWeek(-1), endOfWeek(-1))` or
+// `status CHANGED FROM 'In Progress' TO 'Done' AFTER -2w`. If Operator is empty, it is `WAS` if
+// there are values and `CHANGED` if not. `WAS` and `WAS NOT` with more than one value become
+// `WAS IN` and `WAS NOT IN`. Predicates are dates, date functions or, for By, users.
+type JQLHistory struct {
+	Field    string   `yaml:"field"`
+	Operator string   `yaml:"operator,omitempty"` // `OperatorWas`, `OperatorWasNot`, `OperatorWasIn`, `OperatorWasNotIn` or `OperatorChanged`
+	Values   []string `yaml:"values,omitempty"`
+	From     string   `yaml:"from,omitempty"` // `CHANGED` only
+	To       string   `yaml:"to,omitempty"`   // `CHANGED` only
+	By       string   `yaml:"by,omitempty"`
+	After    string   `yaml:"after,omitempty"`
+	Before   string   `yaml:"before,omitempty"`
+	On       string   `yaml:"on,omitempty"`
+	During   []string `yaml:"during,omitempty"` // start and end
+}
+
+func (h JQLHistory) String() string {
+	field := strings.TrimSpace(h.Field)
+	values := stringsutil.SliceCondenseSpace(h.Values, true, true)
+	op := strings.ToUpper(strings.Join(strings.Fields(h.Operator), " "))
+	if op == "" {
+		if len(values) > 0 {
+			op = OperatorWas
+		} else {
+			op = OperatorChanged
+		}
+	}
+	listFunc := len(values) == 1 && jqlIsListFunc(values[0])
+	switch op {
+	case OperatorWas:
+		if len(values) > 1 || listFunc {
+			op = OperatorWasIn
+		}
+	case OperatorWasNot:
+		if len(values) > 1 || listFunc {
+			op = OperatorWasNotIn
+		}
+	}
+	if field == "" || (op != OperatorChanged && len(values) == 0) {
+		return ""
+	}
+
+	parts := []string{quoteFieldIfNeeded(field), op}
+	switch {
+	case op == OperatorChanged:
+	case listFunc:
+		parts = append(parts, values[0])
+	case op == OperatorWasIn || op == OperatorWasNotIn:
+		var quoted []string
+		for _, v := range values {
+			quoted = append(quoted, jqlBuilderValue(v))
+		}
+		parts = append(parts, addParen(strings.Join(quoted, JQLInSep)))
+	default:
+		parts = append(parts, jqlBuilderValue(values[0]))
+	}
+	for _, p := range []struct{ name, value string }{
+		{JQLPredicateFrom, h.From},
+		{JQLPredicateTo, h.To},
+		{JQLPredicateBy, h.By},
+		{JQLPredicateAfter, h.After},
+		{JQLPredicateBefore, h.Before},
+		{JQLPredicateOn, h.On},
+	} {
+		if v := strings.TrimSpace(p.value); v == "" {
+			continue
+		} else if p.name == JQLPredicateFrom || p.name == JQLPredicateTo || p.name == JQLPredicateBy {
+			parts = append(parts, p.name, jqlBuilderValue(v))
+		} else {
+			parts = append(parts, p.name, jqlBuilderDateValue(v))
+		}
+	}
+	if during := stringsutil.SliceCondenseSpace(h.During, false, false); len(during) == 2 {
+		parts = append(parts, JQLPredicateDuring,
+			addParen(jqlBuilderDateValue(during[0])+JQLInSep+" "+jqlBuilderDateValue(during[1])))
+	}
+	return strings.Join(parts, " ")
+}
+
+// JQLOrder is a field in the `ORDER BY` list.
+type JQLOrder struct {
+	Field string `yaml:"field"`
+	Desc  bool   `yaml:"desc,omitempty"`
+}
+
+func (o JQLOrder) String() string {
+	field := strings.TrimSpace(o.Field)
+	if field == "" {
+		return ""
+	} else if o.Desc {
+		return quoteFieldIfNeeded(field) + " " + JQLKeywordDesc
+	}
+	return quoteFieldIfNeeded(field)
+}
+
+// ReadFileJQL reads a `JQL` from a YAML file written by `JQL.WriteFileYAML`. For example:
+//
+//	meta:
+//	  name: My open work
+//	projectsIncl: [[FOO]]
+//	assigneesIncl: [[currentUser()]]
+//	sprintsIncl: [[openSprints()]]
+//	dates:
+//	  - {field: updated, operator: ">=", value: "startOfDay(-7)"}
+//	history:
+//	  - {field: status, operator: CHANGED, to: Done, during: [startOfWeek(), now()]}
+//	orderBy:
+//	  - {field: priority, desc: true}
+func ReadFileJQL(filename string) (*JQL, error) {
+	b, err := os.ReadFile(filename)
+	if err != nil {
+		return nil, err
+	}
+	j := &JQL{}
+	if err := yaml.Unmarshal(b, j); err != nil {
+		return nil, fmt.Errorf("invalid jql file (%s): %w", filename, err)
+	}
+	return j, nil
+}
+
+// WriteFileYAML writes the `JQL` as a YAML file. `AnyIncl` and `AnyExcl` are not written.
+func (j JQL) WriteFileYAML(filename string, perm os.FileMode) error {
+	b, err := yaml.Marshal(j)
+	if err != nil {
+		return err
+	}
+	return os.WriteFile(filename, b, perm)
+}
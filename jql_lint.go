@@ -0,0 +1,329 @@
+package gojira
+
+import (
+	"errors"
+	"fmt"
+	"slices"
+	"sort"
+	"strings"
+	"unicode/utf8"
+)
+
+// JQL lint warning codes.
+const (
+	JQLLintCodeSyntax    = "syntax"        // the query cannot be parsed
+	JQLLintCodeInvalid   = "invalid"       // Jira reported an error for the query
+	JQLLintCodeField     = "unknown-field" // the field is not a system field or a known custom field
+	JQLLintCodeValue     = "unknown-value" // the project, status or issue type is not known
+	JQLLintCodeEpicLink  = "epic-link"     // `Epic Link` is deprecated in favor of `parent`
+	JQLLintCodeUnbounded = "unbounded"     // the query is not limited to projects, issues or dates
+)
+
+// JQL lint warning severities. Errors mean the query will fail; warnings mean it may not return
+// what was intended.
+const (
+	JQLLintSeverityError = "error"
+	JQLLintSeverityWarn  = "warning"
+)
+
+const jqlLintSuggestionsMax = 3
+
+// JQLLintWarning is a problem found in a JQL query. Pos is zero for problems that are not at a
+// position, such as errors reported by Jira.
+type JQLLintWarning struct {
+	Code        string   `json:"code"`
+	Severity    string   `json:"severity"`
+	Message     string   `json:"message"`
+	Clause      string   `json:"clause,omitempty"`
+	Pos         JQLPos   `json:"pos"`
+	Suggestions []string `json:"suggestions,omitempty"`
+}
+
+func (w JQLLintWarning) String() string {
+	var parts []string
+	if w.Pos.Line > 0 {
+		parts = append(parts, fmt.Sprintf("line %d, column %d:", w.Pos.Line, w.Pos.Column))
+	}
+	parts = append(parts, fmt.Sprintf("%s [%s]: %s", w.Severity, w.Code, w.Message))
+	if len(w.Suggestions) > 0 {
+		parts = append(parts, fmt.Sprintf("(did you mean %s?)", strings.Join(w.Suggestions, ", ")))
+	}
+	return strings.Join(parts, " ")
+}
+
+// JQLLintWarnings is a list of lint warnings.
+type JQLLintWarnings []JQLLintWarning
+
+// HasErrors reports if any warning has the severity `JQLLintSeverityError`.
+func (ws JQLLintWarnings) HasErrors() bool {
+	for _, w := range ws {
+		if w.Severity == JQLLintSeverityError {
+			return true
+		}
+	}
+	return false
+}
+
+// JQLLintCatalog lists the fields and values known to a Jira instance. Checks for empty lists are
+// skipped. Fields are custom field names and clause names, such as `Story Points` or `cf[10016]`;
+// system fields are always known. Projects are keys or names.
+type JQLLintCatalog struct {
+	Fields     []string `json:"fields,omitempty"`
+	Projects   []string `json:"projects,omitempty"`
+	Statuses   []string `json:"statuses,omitempty"`
+	IssueTypes []string `json:"issueTypes,omitempty"`
+}
+
+// jqlSystemFields are the lower case names of Jira system fields and their aliases.
+var jqlSystemFields = map[string]bool{
+	"affectedversion": true, "approvals": true, "assignee": true, "attachments": true,
+	"category": true, "comment": true, "component": true, "created": true, "createddate": true,
+	"creator": true, "description": true, "due": true, "duedate": true, "environment": true,
+	"filter": true, "fixversion": true, "id": true, "issue": true, "issuekey": true,
+	"issuelink": true, "issuelinktype": true, "issuetype": true, "key": true, "labels": true,
+	"lastviewed": true, "level": true, "originalestimate": true, "parent": true, "priority": true,
+	"project": true, "projecttype": true, "remainingestimate": true, "reporter": true,
+	"request": true, "resolution": true, "resolutiondate": true, "resolved": true, "sprint": true,
+	"status": true, "statuscategory": true, "statuscategorychangeddate": true, "subtasks": true,
+	"summary": true, "text": true, "timeestimate": true, "timeoriginalestimate": true,
+	"timespent": true, "type": true, "updated": true, "updateddate": true, "voter": true,
+	"votes": true, "watcher": true, "watchers": true, "workratio": true, "worklogauthor": true,
+	"worklogcomment": true, "worklogdate": true,
+}
+
+// jqlBoundingFields limit a query to projects, issues or a board when compared with `=` or `IN`.
+var jqlBoundingFields = map[string]bool{
+	FieldFilter: true, FieldIssue: true, AliasIssueKey: true, FieldKey: true, FieldParent: true,
+	FieldProject: true, FieldSprint: true, "id": true, "epic link": true,
+}
+
+// jqlBoundingDateFields limit a query to a date range when compared with `>`, `>=` or `=`.
+var jqlBoundingDateFields = map[string]bool{
+	"created": true, FieldCreatedDate: true, FieldUpdated: true, "updateddate": true,
+	"resolved": true, "resolutiondate": true,
+}
+
+// LintJQL checks a query with the JQL parser and against a catalog of known fields and values,
+// which can be nil. It returns:
+//
+//   - a `JQLLintCodeSyntax` error if the query cannot be parsed
+//   - `JQLLintCodeField` warnings for fields that are not system fields or in the catalog; without
+//     catalog fields, only for names close to a system field
+//   - `JQLLintCodeValue` warnings for projects, statuses and issue types not in the catalog
+//   - `JQLLintCodeEpicLink` warnings for `Epic Link`, which Jira Cloud replaced with `parent`
+//   - a `JQLLintCodeUnbounded` warning if the query is not limited to projects, issues, a sprint
+//     or a date range
+//
+// Unknown fields and values include the closest known names as suggestions.
+func LintJQL(jql string, cat *JQLLintCatalog) JQLLintWarnings {
+	q, err := ParseJQL(jql)
+	if err != nil {
+		w := JQLLintWarning{Code: JQLLintCodeSyntax, Severity: JQLLintSeverityError, Message: err.Error()}
+		var synErr *JQLSyntaxError
+		if errors.As(err, &synErr) {
+			w.Message = synErr.Msg
+			w.Pos = synErr.Pos
+		}
+		return JQLLintWarnings{w}
+	}
+	if cat == nil {
+		cat = &JQLLintCatalog{}
+	}
+	var ws JQLLintWarnings
+	for _, c := range q.Clauses() {
+		ws = append(ws, cat.lintClause(c)...)
+	}
+	if !jqlBounded(q.Where) {
+		ws = append(ws, JQLLintWarning{
+			Code:     JQLLintCodeUnbounded,
+			Severity: JQLLintSeverityWarn,
+			Message:  "query is not limited to projects, issues, a sprint or a date range and may be slow or return many issues"})
+	}
+	return ws
+}
+
+func (cat *JQLLintCatalog) lintClause(c *JQLClause) JQLLintWarnings {
+	name := strings.ToLower(strings.TrimSpace(c.Field.Name))
+	if name == "epic link" || name == "epiclink" {
+		w := JQLLintWarning{
+			Code:     JQLLintCodeEpicLink,
+			Severity: JQLLintSeverityWarn,
+			Message:  "`Epic Link` is deprecated; use `parent`",
+			Clause:   c.String(),
+			Pos:      c.Pos}
+		if c.Value != nil && len(c.Predicates) == 0 {
+			w.Suggestions = []string{FieldParent + " " + c.Operator + " " + c.Value.String()}
+		}
+		return JQLLintWarnings{w}
+	} else if jqlSystemFields[name] {
+		return cat.lintValues(c, name)
+	} else if _, ok := IsCustomFieldKey(c.Field.Name); ok || jqlContainsFold(cat.Fields, c.Field.Name) {
+		return nil
+	}
+	known := slices.Clone(cat.Fields)
+	for f := range jqlSystemFields {
+		known = append(known, f)
+	}
+	suggestions := jqlSuggest(c.Field.Name, known)
+	if len(cat.Fields) == 0 && len(suggestions) == 0 {
+		// Without known custom fields, only names close to a system field are likely mistakes.
+		return nil
+	}
+	return JQLLintWarnings{{
+		Code:        JQLLintCodeField,
+		Severity:    JQLLintSeverityWarn,
+		Message:     fmt.Sprintf("unknown field (%s)", c.Field.Name),
+		Clause:      c.String(),
+		Pos:         c.Field.Pos,
+		Suggestions: suggestions}}
+}
+
+func (cat *JQLLintCatalog) lintValues(c *JQLClause, field string) JQLLintWarnings {
+	var known []string
+	var kind string
+	switch field {
+	case FieldProject:
+		known, kind = cat.Projects, "project"
+	case FieldStatus:
+		known, kind = cat.Statuses, "status"
+	case "issuetype", FieldType:
+		known, kind = cat.IssueTypes, "issue type"
+	}
+	if len(known) == 0 || c.Value == nil {
+		return nil
+	}
+	var lits []*JQLLiteral
+	switch v := c.Value.(type) {
+	case *JQLLiteral:
+		lits = append(lits, v)
+	case *JQLList:
+		for _, x := range v.Values {
+			if lit, ok := x.(*JQLLiteral); ok {
+				lits = append(lits, lit)
+			}
+		}
+	}
+	var ws JQLLintWarnings
+	for _, lit := range lits {
+		if lit.Empty || jqlIsDigits(lit.Value) || jqlContainsFold(known, lit.Value) {
+			continue
+		}
+		ws = append(ws, JQLLintWarning{
+			Code:        JQLLintCodeValue,
+			Severity:    JQLLintSeverityWarn,
+			Message:     fmt.Sprintf("unknown %s (%s)", kind, lit.Value),
+			Clause:      c.String(),
+			Pos:         lit.Pos,
+			Suggestions: jqlSuggest(lit.Value, known)})
+	}
+	return ws
+}
+
+// jqlBounded reports if every issue matching e must match a positive clause on a bounding field.
+func jqlBounded(e JQLExpr) bool {
+	switch x := e.(type) {
+	case *JQLAnd:
+		for _, sub := range x.Exprs {
+			if jqlBounded(sub) {
+				return true
+			}
+		}
+		return false
+	case *JQLOr:
+		for _, sub := range x.Exprs {
+			if !jqlBounded(sub) {
+				return false
+			}
+		}
+		return len(x.Exprs) > 0
+	case *JQLClause:
+		name := strings.ToLower(strings.TrimSpace(x.Field.Name))
+		switch x.Operator {
+		case OperatorEQ, OperatorIn:
+			return jqlBoundingFields[name] || jqlBoundingDateFields[name]
+		case OperatorGT, OperatorGTE:
+			return jqlBoundingDateFields[name]
+		}
+	}
+	return false
+}
+
+// jqlSuggest returns up to 3 known names closest to s by edit distance, case-insensitive.
+func jqlSuggest(s string, known []string) []string {
+	s = strings.ToLower(strings.TrimSpace(s))
+	maxDist := max(1, utf8.RuneCountInString(s)/3)
+	type match struct {
+		name string
+		dist int
+	}
+	var matches []match
+	seen := map[string]bool{}
+	for _, k := range known {
+		k = strings.TrimSpace(k)
+		kl := strings.ToLower(k)
+		if k == "" || seen[kl] {
+			continue
+		}
+		seen[kl] = true
+		if d := jqlEditDistance(s, kl); d <= maxDist || (len(s) > 2 && strings.HasPrefix(kl, s)) {
+			matches = append(matches, match{name: k, dist: d})
+		}
+	}
+	sort.Slice(matches, func(i, j int) bool {
+		if matches[i].dist != matches[j].dist {
+			return matches[i].dist < matches[j].dist
+		}
+		return matches[i].name < matches[j].name
+	})
+	var out []string
+	for i := 0; i < len(matches) && i < jqlLintSuggestionsMax; i++ {
+		out = append(out, matches[i].name)
+	}
+	return out
+}
+
+// jqlEditDistance returns the edit distance between a and b in runes, counting insertions,
+// deletions, substitutions and transpositions of adjacent runes as one edit each.
+func jqlEditDistance(a, b string) int {
+	ra, rb := []rune(a), []rune(b)
+	d := make([][]int, len(ra)+1)
+	for i := range d {
+		d[i] = make([]int, len(rb)+1)
+		d[i][0] = i
+	}
+	for j := range d[0] {
+		d[0][j] = j
+	}
+	for i := 1; i <= len(ra); i++ {
+		for j := 1; j <= len(rb); j++ {
+			cost := 1
+			if ra[i-1] == rb[j-1] {
+				cost = 0
+			}
+			d[i][j] = min(d[i-1][j]+1, d[i][j-1]+1, d[i-1][j-1]+cost)
+			if i > 1 && j > 1 && ra[i-1] == rb[j-2] && ra[i-2] == rb[j-1] {
+				d[i][j] = min(d[i][j], d[i-2][j-2]+1)
+			}
+		}
+	}
+	return d[len(ra)][len(rb)]
+}
+
+func jqlContainsFold(vals []string, s string) bool {
+	s = strings.TrimSpace(s)
+	for _, v := range vals {
+		if strings.EqualFold(strings.TrimSpace(v), s) {
+			return true
+		}
+	}
+	return false
+}
+
+func jqlIsDigits(s string) bool {
+	for _, r := range s {
+		if r < '0' || r > '9' {
+			return false
+		}
+	}
+	return s != ""
+}
@@ -0,0 +1,47 @@
+package gojira
+
+import (
+	"strconv"
+	"strings"
+	"testing"
+)
+
+var lintJQLTests = []struct {
+	jql  string
+	want string // code:column:suggestions
+}{
+	{`project = ABC AND status = "In Progress"`, ""},
+	{`project = ABC AND stauts = Open`, "unknown-field:19:status"},
+	{`project = ABC AND status IN (Open, "In Progres")`, `unknown-value:36:In Progress`},
+	{`project = ABCD AND issuetype = Storey`, "unknown-value:11:ABC|unknown-value:32:Story"},
+	{`project = ABC AND "Epic Link" = ABC-1`, "epic-link:19:parent = ABC-1"},
+	{`"Story Pionts" > 3 AND cf[10016] > 3 AND project = ABC`, "unknown-field:1:Story Points"},
+	{`status = Open ORDER BY created`, "unbounded:0:"},
+	{`status = Open AND (project = ABC OR created >= -1w)`, ""},
+	{`status = Open OR project = ABC`, "unbounded:0:"},
+	{`project = ABC AND (status = Open`, "syntax:33:"},
+}
+
+func TestLintJQL(t *testing.T) {
+	cat := &JQLLintCatalog{
+		Fields:     []string{"Story Points", "cf[10016]"},
+		Projects:   []string{"ABC", "Alpha"},
+		Statuses:   []string{"Open", "In Progress", "Done"},
+		IssueTypes: []string{"Bug", "Story", "Epic"}}
+	for _, tt := range lintJQLTests {
+		var got []string
+		for _, w := range LintJQL(tt.jql, cat) {
+			got = append(got, w.Code+":"+strconv.Itoa(w.Pos.Column)+":"+strings.Join(w.Suggestions, ","))
+		}
+		if strings.Join(got, "|") != tt.want {
+			t.Errorf("gojira.LintJQL(\"%s\") mismatch: want (%s), got (%s)", tt.jql, tt.want, strings.Join(got, "|"))
+		}
+	}
+}
+
+func TestLintJQLNoCatalog(t *testing.T) {
+	ws := LintJQL(`project = ABC AND stauts = Open AND "Story Points" > 3`, nil)
+	if len(ws) != 1 || ws[0].Code != JQLLintCodeField || strings.Join(ws[0].Suggestions, ",") != "status" {
+		t.Errorf("gojira.LintJQL() mismatch: want (unknown-field status), got (%v)", ws)
+	}
+}
@@ -0,0 +1,522 @@
+package gojira
+
+import (
+	"fmt"
+	"slices"
+	"strings"
+	"unicode"
+	"unicode/utf8"
+)
+
+// JQL keywords.
+const (
+	JQLKeywordAnd     = "AND"
+	JQLKeywordAsc     = "ASC"
+	JQLKeywordBy      = "BY"
+	JQLKeywordChanged = "CHANGED"
+	JQLKeywordDesc    = "DESC"
+	JQLKeywordEmpty   = "EMPTY"
+	JQLKeywordIn      = "IN"
+	JQLKeywordIs      = "IS"
+	JQLKeywordNot     = "NOT"
+	JQLKeywordNull    = "NULL"
+	JQLKeywordOr      = "OR"
+	JQLKeywordOrder   = "ORDER"
+	JQLKeywordWas     = "WAS"
+)
+
+// jqlReservedWords cannot be used unquoted as field names or values.
+var jqlReservedWords = []string{
+	JQLKeywordAnd, JQLKeywordChanged, JQLKeywordEmpty, JQLKeywordIn, JQLKeywordIs, JQLKeywordNot,
+	JQLKeywordNull, JQLKeywordOr, JQLKeywordOrder, JQLKeywordWas,
+	JQLPredicateAfter, JQLPredicateBefore, JQLPredicateBy, JQLPredicateDuring, JQLPredicateFrom,
+	JQLPredicateOn, JQLPredicateTo}
+
+func jqlIsReservedWord(s string) bool {
+	return slices.Contains(jqlReservedWords, strings.ToUpper(s))
+}
+
+// jqlIsWordRune reports if a rune can be part of an unquoted word, such as `cf[10010]`, `ABC-123`,
+// `-1w` or `2024-01-31`.
+func jqlIsWordRune(r rune) bool {
+	if unicode.IsSpace(r) {
+		return false
+	}
+	return !strings.ContainsRune(`=!<>~(),"'&|`, r)
+}
+
+// JQLSyntaxError is a JQL parse error at a position in the query.
+type JQLSyntaxError struct {
+	Pos JQLPos
+	Msg string
+}
+
+func (e *JQLSyntaxError) Error() string {
+	return fmt.Sprintf("jql syntax error at line %d, column %d: %s", e.Pos.Line, e.Pos.Column, e.Msg)
+}
+
+type jqlTokenType int
+
+const (
+	jqlTokenEOF      jqlTokenType = iota
+	jqlTokenWord                  // unquoted word, including keywords
+	jqlTokenString                // quoted string
+	jqlTokenOperator              // =, !=, <, <=, >, >=, ~, !~
+	jqlTokenLParen
+	jqlTokenRParen
+	jqlTokenComma
+	jqlTokenAnd // && or &
+	jqlTokenOr  // || or |
+	jqlTokenNot // !
+)
+
+type jqlToken struct {
+	Type  jqlTokenType
+	Value string // unescaped for strings
+	Pos   JQLPos
+}
+
+// isKeyword reports if the token is an unquoted keyword, case-insensitive.
+func (t jqlToken) isKeyword(kw string) bool {
+	return t.Type == jqlTokenWord && strings.EqualFold(t.Value, kw)
+}
+
+func (t jqlToken) describe() string {
+	switch t.Type {
+	case jqlTokenEOF:
+		return "end of query"
+	case jqlTokenString:
+		return fmt.Sprintf("string %s", jqlQuote(t.Value))
+	default:
+		return fmt.Sprintf("%q", t.Value)
+	}
+}
+
+// jqlLex splits a JQL string into tokens, ending with an EOF token.
+func jqlLex(s string) ([]jqlToken, error) {
+	var tokens []jqlToken
+	pos := JQLPos{Line: 1, Column: 1}
+	advance := func(n int) {
+		for _, r := range s[pos.Offset : pos.Offset+n] {
+			if r == '\n' {
+				pos.Line++
+				pos.Column = 1
+			} else {
+				pos.Column++
+			}
+		}
+		pos.Offset += n
+	}
+	for pos.Offset < len(s) {
+		r, size := utf8.DecodeRuneInString(s[pos.Offset:])
+		start := pos
+		rest := s[pos.Offset:]
+		switch {
+		case unicode.IsSpace(r):
+			advance(size)
+		case r == '(' || r == ')' || r == ',':
+			typ := map[rune]jqlTokenType{'(': jqlTokenLParen, ')': jqlTokenRParen, ',': jqlTokenComma}[r]
+			tokens = append(tokens, jqlToken{Type: typ, Value: string(r), Pos: start})
+			advance(1)
+		case r == '"' || r == '\'':
+			val, n, err := jqlLexString(rest)
+			if err != nil {
+				return nil, &JQLSyntaxError{Pos: start, Msg: err.Error()}
+			}
+			tokens = append(tokens, jqlToken{Type: jqlTokenString, Value: val, Pos: start})
+			advance(n)
+		case strings.HasPrefix(rest, "&&") || strings.HasPrefix(rest, "||"):
+			typ := jqlTokenAnd
+			if r == '|' {
+				typ = jqlTokenOr
+			}
+			tokens = append(tokens, jqlToken{Type: typ, Value: rest[:2], Pos: start})
+			advance(2)
+		case r == '&' || r == '|':
+			typ := jqlTokenAnd
+			if r == '|' {
+				typ = jqlTokenOr
+			}
+			tokens = append(tokens, jqlToken{Type: typ, Value: rest[:1], Pos: start})
+			advance(1)
+		case strings.HasPrefix(rest, "!=") || strings.HasPrefix(rest, "!~") ||
+			strings.HasPrefix(rest, "<=") || strings.HasPrefix(rest, ">="):
+			tokens = append(tokens, jqlToken{Type: jqlTokenOperator, Value: rest[:2], Pos: start})
+			advance(2)
+		case r == '=' || r == '<' || r == '>' || r == '~':
+			tokens = append(tokens, jqlToken{Type: jqlTokenOperator, Value: rest[:1], Pos: start})
+			advance(1)
+		case r == '!':
+			tokens = append(tokens, jqlToken{Type: jqlTokenNot, Value: "!", Pos: start})
+			advance(1)
+		default:
+			n := 0
+			for n < len(rest) {
+				wr, wsize := utf8.DecodeRuneInString(rest[n:])
+				if !jqlIsWordRune(wr) {
+					break
+				}
+				n += wsize
+			}
+			tokens = append(tokens, jqlToken{Type: jqlTokenWord, Value: rest[:n], Pos: start})
+			advance(n)
+		}
+	}
+	return append(tokens, jqlToken{Type: jqlTokenEOF, Pos: pos}), nil
+}
+
+// jqlLexString reads a quoted string at the start of s, returning the unescaped value and the
+// number of bytes read.
+func jqlLexString(s string) (string, int, error) {
+	quote := s[0]
+	var sb strings.Builder
+	for i := 1; i < len(s); i++ {
+		switch c := s[i]; {
+		case c == quote:
+			return sb.String(), i + 1, nil
+		case c == '\\' && i+1 < len(s):
+			i++
+			switch s[i] {
+			case 'n':
+				sb.WriteByte('\n')
+			case 't':
+				sb.WriteByte('\t')
+			default:
+				sb.WriteByte(s[i])
+			}
+		default:
+			sb.WriteByte(c)
+		}
+	}
+	return "", 0, fmt.Errorf("unterminated string, missing %c", quote)
+}
+
+// ParseJQL parses a JQL query. Keywords are case-insensitive, and `&&`, `||` and `!` are accepted
+// for `AND`, `OR` and `NOT`. Errors are `*JQLSyntaxError`.
+func ParseJQL(s string) (*JQLQuery, error) {
+	tokens, err := jqlLex(s)
+	if err != nil {
+		return nil, err
+	}
+	p := &jqlParser{tokens: tokens}
+	q := &JQLQuery{}
+	if !p.peek().isKeyword(JQLKeywordOrder) && p.peek().Type != jqlTokenEOF {
+		if q.Where, err = p.parseOr(); err != nil {
+			return nil, err
+		}
+	}
+	if p.peek().isKeyword(JQLKeywordOrder) {
+		if q.OrderBy, err = p.parseOrderBy(); err != nil {
+			return nil, err
+		}
+	}
+	if t := p.peek(); t.Type != jqlTokenEOF {
+		if q.Where != nil && t.Type == jqlTokenWord {
+			return nil, p.errorf(t, "expected AND, OR or ORDER BY, found %s", t.describe())
+		}
+		return nil, p.errorf(t, "unexpected %s", t.describe())
+	}
+	return q, nil
+}
+
+type jqlParser struct {
+	tokens []jqlToken
+	i      int
+}
+
+func (p *jqlParser) peek() jqlToken { return p.tokens[p.i] }
+
+func (p *jqlParser) peekN(n int) jqlToken {
+	if p.i+n < len(p.tokens) {
+		return p.tokens[p.i+n]
+	}
+	return p.tokens[len(p.tokens)-1]
+}
+
+func (p *jqlParser) next() jqlToken {
+	t := p.tokens[p.i]
+	if t.Type != jqlTokenEOF {
+		p.i++
+	}
+	return t
+}
+
+func (p *jqlParser) errorf(t jqlToken, format string, a ...any) error {
+	return &JQLSyntaxError{Pos: t.Pos, Msg: fmt.Sprintf(format, a...)}
+}
+
+func (p *jqlParser) parseOr() (JQLExpr, error) {
+	pos := p.peek().Pos
+	var exprs []JQLExpr
+	for {
+		e, err := p.parseAnd()
+		if err != nil {
+			return nil, err
+		}
+		exprs = append(exprs, e)
+		if t := p.peek(); t.Type != jqlTokenOr && !t.isKeyword(JQLKeywordOr) {
+			break
+		}
+		p.next()
+	}
+	if len(exprs) == 1 {
+		return exprs[0], nil
+	}
+	return &JQLOr{Exprs: exprs, Pos: pos}, nil
+}
+
+func (p *jqlParser) parseAnd() (JQLExpr, error) {
+	pos := p.peek().Pos
+	var exprs []JQLExpr
+	for {
+		e, err := p.parseNot()
+		if err != nil {
+			return nil, err
+		}
+		exprs = append(exprs, e)
+		if t := p.peek(); t.Type != jqlTokenAnd && !t.isKeyword(JQLKeywordAnd) {
+			break
+		}
+		p.next()
+	}
+	if len(exprs) == 1 {
+		return exprs[0], nil
+	}
+	return &JQLAnd{Exprs: exprs, Pos: pos}, nil
+}
+
+func (p *jqlParser) parseNot() (JQLExpr, error) {
+	t := p.peek()
+	switch {
+	case t.Type == jqlTokenNot || t.isKeyword(JQLKeywordNot):
+		p.next()
+		e, err := p.parseNot()
+		if err != nil {
+			return nil, err
+		}
+		return &JQLNot{Expr: e, Pos: t.Pos}, nil
+	case t.Type == jqlTokenLParen:
+		p.next()
+		e, err := p.parseOr()
+		if err != nil {
+			return nil, err
+		}
+		if rt := p.next(); rt.Type != jqlTokenRParen {
+			return nil, p.errorf(rt, "expected \")\" to close \"(\" at column %d, found %s", t.Pos.Column, rt.describe())
+		}
+		return e, nil
+	default:
+		return p.parseClause()
+	}
+}
+
+func (p *jqlParser) parseField() (JQLField, error) {
+	t := p.next()
+	switch {
+	case t.Type == jqlTokenString:
+		return JQLField{Name: t.Value, Pos: t.Pos}, nil
+	case t.Type == jqlTokenWord && !jqlIsReservedWord(t.Value):
+		return JQLField{Name: t.Value, Pos: t.Pos}, nil
+	default:
+		return JQLField{}, p.errorf(t, "expected field, found %s", t.describe())
+	}
+}
+
+func (p *jqlParser) parseClause() (JQLExpr, error) {
+	field, err := p.parseField()
+	if err != nil {
+		return nil, err
+	}
+	c := &JQLClause{Field: field, Pos: field.Pos}
+	t := p.next()
+	switch {
+	case t.Type == jqlTokenOperator:
+		c.Operator = t.Value
+		c.Value, err = p.parseOperand(false)
+	case t.isKeyword(JQLKeywordIn):
+		c.Operator = OperatorIn
+		c.Value, err = p.parseListOrFunction()
+	case t.isKeyword(JQLKeywordNot) && p.peek().isKeyword(JQLKeywordIn):
+		p.next()
+		c.Operator = OperatorNotIn
+		c.Value, err = p.parseListOrFunction()
+	case t.isKeyword(JQLKeywordIs):
+		c.Operator = OperatorIs
+		if p.peek().isKeyword(JQLKeywordNot) {
+			p.next()
+			c.Operator = OperatorIsNot
+		}
+		if et := p.next(); et.isKeyword(JQLKeywordEmpty) || et.isKeyword(JQLKeywordNull) {
+			c.Value = &JQLLiteral{Value: JQLKeywordEmpty, Empty: true, Pos: et.Pos}
+		} else {
+			return nil, p.errorf(et, "expected EMPTY or NULL after %s, found %s", c.Operator, et.describe())
+		}
+	case t.isKeyword(JQLKeywordWas):
+		c.Operator = OperatorWas
+		if p.peek().isKeyword(JQLKeywordNot) {
+			p.next()
+			c.Operator = OperatorWasNot
+		}
+		if p.peek().isKeyword(JQLKeywordIn) {
+			p.next()
+			c.Operator += " " + JQLKeywordIn
+			c.Value, err = p.parseListOrFunction()
+		} else {
+			c.Value, err = p.parseOperand(false)
+		}
+		if err == nil {
+			c.Predicates, err = p.parsePredicates()
+		}
+	case t.isKeyword(JQLKeywordChanged):
+		c.Operator = OperatorChanged
+		c.Predicates, err = p.parsePredicates()
+	default:
+		return nil, p.errorf(t, "expected operator after field %s, found %s", field.String(), t.describe())
+	}
+	if err != nil {
+		return nil, err
+	}
+	return c, nil
+}
+
+// parseOperand parses a literal, `EMPTY`, a function or, if allowList, a list.
+func (p *jqlParser) parseOperand(allowList bool) (JQLValue, error) {
+	t := p.peek()
+	switch {
+	case t.Type == jqlTokenString:
+		p.next()
+		return &JQLLiteral{Value: t.Value, Quoted: true, Pos: t.Pos}, nil
+	case t.isKeyword(JQLKeywordEmpty) || t.isKeyword(JQLKeywordNull):
+		p.next()
+		return &JQLLiteral{Value: JQLKeywordEmpty, Empty: true, Pos: t.Pos}, nil
+	case t.Type == jqlTokenWord && p.peekN(1).Type == jqlTokenLParen:
+		return p.parseFunction()
+	case t.Type == jqlTokenWord && !jqlIsReservedWord(t.Value):
+		p.next()
+		return &JQLLiteral{Value: t.Value, Pos: t.Pos}, nil
+	case t.Type == jqlTokenLParen && allowList:
+		return p.parseList()
+	default:
+		return nil, p.errorf(t, "expected value, found %s", t.describe())
+	}
+}
+
+func (p *jqlParser) parseListOrFunction() (JQLValue, error) {
+	t := p.peek()
+	if t.Type == jqlTokenLParen {
+		return p.parseList()
+	} else if t.Type == jqlTokenWord && p.peekN(1).Type == jqlTokenLParen {
+		return p.parseFunction()
+	}
+	return nil, p.errorf(t, "expected \"(\" or function, found %s", t.describe())
+}
+
+func (p *jqlParser) parseList() (JQLValue, error) {
+	open := p.next()
+	list := &JQLList{Pos: open.Pos}
+	for {
+		v, err := p.parseOperand(false)
+		if err != nil {
+			return nil, err
+		}
+		list.Values = append(list.Values, v)
+		switch t := p.next(); t.Type {
+		case jqlTokenComma:
+			continue
+		case jqlTokenRParen:
+			return list, nil
+		default:
+			return nil, p.errorf(t, "expected \",\" or \")\" in list, found %s", t.describe())
+		}
+	}
+}
+
+func (p *jqlParser) parseFunction() (JQLValue, error) {
+	name := p.next()
+	p.next() // (
+	fn := &JQLFunction{Name: name.Value, Pos: name.Pos}
+	if p.peek().Type == jqlTokenRParen {
+		p.next()
+		return fn, nil
+	}
+	for {
+		t := p.next()
+		switch t.Type {
+		case jqlTokenString:
+			fn.Args = append(fn.Args, &JQLLiteral{Value: t.Value, Quoted: true, Pos: t.Pos})
+		case jqlTokenWord:
+			fn.Args = append(fn.Args, &JQLLiteral{Value: t.Value, Pos: t.Pos})
+		default:
+			return nil, p.errorf(t, "expected argument to %s(), found %s", fn.Name, t.describe())
+		}
+		switch t := p.next(); t.Type {
+		case jqlTokenComma:
+			continue
+		case jqlTokenRParen:
+			return fn, nil
+		default:
+			return nil, p.errorf(t, "expected \",\" or \")\" in %s(), found %s", fn.Name, t.describe())
+		}
+	}
+}
+
+func (p *jqlParser) parsePredicates() ([]JQLPredicate, error) {
+	var preds []JQLPredicate
+	for {
+		t := p.peek()
+		if t.Type != jqlTokenWord {
+			return preds, nil
+		}
+		name := strings.ToUpper(t.Value)
+		pred := JQLPredicate{Name: name, Pos: t.Pos}
+		var err error
+		switch name {
+		case JQLPredicateAfter, JQLPredicateBefore, JQLPredicateOn, JQLPredicateFrom, JQLPredicateTo:
+			p.next()
+			pred.Value, err = p.parseOperand(false)
+		case JQLPredicateBy:
+			p.next()
+			pred.Value, err = p.parseOperand(true)
+		case JQLPredicateDuring:
+			p.next()
+			if lt := p.peek(); lt.Type != jqlTokenLParen {
+				return nil, p.errorf(lt, "expected \"(\" after DURING, found %s", lt.describe())
+			}
+			pred.Value, err = p.parseList()
+			if err == nil && len(pred.Value.(*JQLList).Values) != 2 {
+				err = p.errorf(t, "DURING takes two values, a start and an end")
+			}
+		default:
+			return preds, nil
+		}
+		if err != nil {
+			return nil, err
+		}
+		preds = append(preds, pred)
+	}
+}
+
+// parseOrderBy parses `ORDER BY field [ASC|DESC], ...`.
+func (p *jqlParser) parseOrderBy() ([]JQLOrderBy, error) {
+	p.next() // ORDER
+	if t := p.next(); !t.isKeyword(JQLKeywordBy) {
+		return nil, p.errorf(t, "expected BY after ORDER, found %s", t.describe())
+	}
+	var out []JQLOrderBy
+	for {
+		field, err := p.parseField()
+		if err != nil {
+			return nil, err
+		}
+		o := JQLOrderBy{Field: field, Pos: field.Pos}
+		if t := p.peek(); t.isKeyword(JQLKeywordAsc) || t.isKeyword(JQLKeywordDesc) {
+			p.next()
+			o.Direction = strings.ToUpper(t.Value)
+		}
+		out = append(out, o)
+		if p.peek().Type != jqlTokenComma {
+			return out, nil
+		}
+		p.next()
+	}
+}
@@ -0,0 +1,100 @@
+package gojira
+
+import (
+	"errors"
+	"testing"
+)
+
+var parseJQLTests = []struct {
+	v    string
+	want string
+}{
+	{`project = ABC`, `project = ABC`},
+	{`project=ABC and status in (Open,'In Progress') order by created desc, key`,
+		`project = ABC AND status IN (Open, "In Progress") ORDER BY created DESC, key`},
+	{`status NOT IN ("Done", Closed) && assignee != currentUser()`,
+		`status NOT IN ("Done", Closed) AND assignee != currentUser()`},
+	{`(a = 1 OR b = 2) AND NOT (c = 3 || d = 4)`, `(a = 1 OR b = 2) AND NOT (c = 3 OR d = 4)`},
+	{`a = 1 OR b = 2 AND c = 3`, `a = 1 OR b = 2 AND c = 3`},
+	{`a = 1 OR (b = 2 OR c = 3)`, `a = 1 OR (b = 2 OR c = 3)`},
+	{`!(labels is empty) and fixVersion is not null`, `NOT labels IS EMPTY AND fixVersion IS NOT EMPTY`},
+	{`summary ~ "login \"fails\"" AND description !~ 'it\'s'`, `summary ~ "login \"fails\"" AND description !~ "it's"`},
+	{`"Story Points" >= 3 AND cf[10010] <= 8`, `"Story Points" >= 3 AND cf[10010] <= 8`},
+	{`created >= -30d AND updated < startOfWeek(-1w)`, `created >= -30d AND updated < startOfWeek(-1w)`},
+	{`assignee in membersOf("team-a") AND sprint in openSprints()`, `assignee IN membersOf("team-a") AND sprint IN openSprints()`},
+	{`status was not in (Open, Reopened) by (alice, bob) during ("2024-01-01", "2024-02-01")`,
+		`status WAS NOT IN (Open, Reopened) BY (alice, bob) DURING ("2024-01-01", "2024-02-01")`},
+	{`status was "In Progress" before 2024-01-01`, `status WAS "In Progress" BEFORE 2024-01-01`},
+	{`status changed from Open to Done after -1w by currentUser()`,
+		`status CHANGED FROM Open TO Done AFTER -1w BY currentUser()`},
+	{`assignee changed`, `assignee CHANGED`},
+	{`ORDER BY Rank ASC`, `ORDER BY Rank ASC`},
+	{`issue in linkedIssues(ABC-1, "is blocked by")`, `issue IN linkedIssues(ABC-1, "is blocked by")`},
+	{"", ""},
+}
+
+func TestParseJQL(t *testing.T) {
+	for _, tt := range parseJQLTests {
+		q, err := ParseJQL(tt.v)
+		if err != nil {
+			t.Errorf("gojira.ParseJQL(%q) error: (%s)", tt.v, err.Error())
+			continue
+		}
+		got := q.String()
+		if got != tt.want {
+			t.Errorf("gojira.ParseJQL(%q) mismatch: want (%s), got (%s)", tt.v, tt.want, got)
+		}
+		q2, err := ParseJQL(got)
+		if err != nil {
+			t.Errorf("gojira.ParseJQL(%q) round trip error: (%s)", got, err.Error())
+		} else if got2 := q2.String(); got2 != got {
+			t.Errorf("gojira.ParseJQL(%q) round trip mismatch: want (%s), got (%s)", got, got, got2)
+		}
+	}
+}
+
+var parseJQLErrorTests = []struct {
+	v      string
+	line   int
+	column int
+}{
+	{`project = `, 1, 11},
+	{`project ABC`, 1, 9},
+	{`status in Open`, 1, 11},
+	{`(a = 1 OR b = 2`, 1, 16},
+	{`a = 1 b = 2`, 1, 7},
+	{`summary ~ "open`, 1, 11},
+	{"project = ABC\nAND status is Open", 2, 15},
+	{`a = 1 ORDER created`, 1, 13},
+	{`and = 1`, 1, 1},
+	{`status changed during (2024-01-01)`, 1, 16},
+}
+
+func TestParseJQLErrors(t *testing.T) {
+	for _, tt := range parseJQLErrorTests {
+		_, err := ParseJQL(tt.v)
+		var synErr *JQLSyntaxError
+		if !errors.As(err, &synErr) {
+			t.Errorf("gojira.ParseJQL(%q) mismatch: want (*JQLSyntaxError), got (%v)", tt.v, err)
+			continue
+		}
+		if synErr.Pos.Line != tt.line || synErr.Pos.Column != tt.column {
+			t.Errorf("gojira.ParseJQL(%q) position mismatch: want (%d:%d), got (%d:%d) (%s)",
+				tt.v, tt.line, tt.column, synErr.Pos.Line, synErr.Pos.Column, synErr.Error())
+		}
+	}
+}
+
+func TestJQLQueryClauses(t *testing.T) {
+	q, err := ParseJQL(`project = ABC AND (status = Open OR NOT labels IS EMPTY)`)
+	if err != nil {
+		t.Fatalf("gojira.ParseJQL() error: (%s)", err.Error())
+	}
+	var fields []string
+	for _, c := range q.Clauses() {
+		fields = append(fields, c.Field.Name)
+	}
+	if len(fields) != 3 || fields[0] != "project" || fields[1] != "status" || fields[2] != "labels" {
+		t.Errorf("JQLQuery.Clauses() mismatch: want ([project status labels]), got (%v)", fields)
+	}
+}
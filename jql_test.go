@@ -0,0 +1,70 @@
+package gojira
+
+import (
+	"path/filepath"
+	"testing"
+)
+
+var jqlStringTests = []struct {
+	jql  JQL
+	want string
+}{
+	{JQL{ProjectsIncl: [][]string{{"FOO"}}, StatusesIncl: [][]string{{"Open", "In Progress"}}},
+		"project = 'FOO' AND status IN ('In Progress','Open')"},
+	{JQL{AssigneesIncl: [][]string{{JQLFuncCurrentUser}}, ReportersExcl: [][]string{{JQLMembersOf("team a")}}},
+		`assignee = currentUser() AND reporter NOT IN membersOf("team a")`},
+	{JQL{SprintsIncl: [][]string{{JQLFuncOpenSprints}}, WatchersIncl: [][]string{{"jdoe", JQLFuncCurrentUser}}},
+		"sprint IN openSprints() AND watcher IN (currentUser(),'jdoe')"},
+	{JQL{IssuesIncl: [][]string{{JQLFuncIssueHistory}}, PrioritiesIncl: [][]string{{"High"}},
+		ComponentsExcl: [][]string{{"API"}}, FixVersionsIncl: [][]string{{"1.0", "1.1"}}},
+		"component != 'API' AND fixVersion IN ('1.0','1.1') AND issue IN issueHistory() AND priority = 'High'"},
+	{JQL{Dates: []JQLDate{
+		{Field: FieldUpdated, Operator: OperatorGTE, Value: JQLStartOfDay("-7")},
+		{Field: "created", Operator: OperatorGT, Value: "-2w"},
+		{Field: FieldDueDate, Operator: OperatorLT, Value: "2024-01-31 12:00"}}},
+		"updated >= startOfDay(-7) AND created > -2w AND duedate < '2024-01-31 12:00'"},
+	{JQL{History: []JQLHistory{
+		{Field: FieldStatus, Values: []string{"In Progress"}, During: []string{JQLStartOfWeek("-1"), JQLEndOfWeek("-1")}},
+		{Field: FieldStatus, Operator: OperatorWasNot, Values: []string{"Open", "Done"}, By: JQLFuncCurrentUser},
+		{Field: FieldStatus, From: "In Progress", To: "Done", After: "-2w"},
+		{Field: FieldAssignee, Values: []string{JQLMembersOf("team-a")}, Before: "2024/01/31"}}},
+		"status WAS 'In Progress' DURING (startOfWeek(-1), endOfWeek(-1)) AND " +
+			"status WAS NOT IN ('Done','Open') BY currentUser() AND " +
+			"status CHANGED FROM 'In Progress' TO 'Done' AFTER -2w AND " +
+			`assignee WAS IN membersOf("team-a") BEFORE 2024/01/31`},
+	{JQL{StatusesIncl: [][]string{{"Blocked (External)"}}, ComponentsIncl: [][]string{{"API (v2)", "Web"}},
+		LabelsIncl: [][]string{{"notAFunc()"}}},
+		"component IN ('API (v2)','Web') AND labels = 'notAFunc()' AND status = 'Blocked (External)'"},
+	{JQL{CustomFieldIncl: map[string][]string{"Story Points": {"3"}, "customfield_10001": {"Team A"}},
+		OrderBy: []JQLOrder{{Field: FieldPriority, Desc: true}, {Field: "Story Points"}}},
+		`"Story Points" = '3' AND cf[10001] = 'Team A' ORDER BY priority DESC, "Story Points"`},
+}
+
+func TestJQLString(t *testing.T) {
+	for _, tt := range jqlStringTests {
+		got := tt.jql.String()
+		if got != tt.want {
+			t.Errorf("gojira.JQL.String() mismatch: want (%s), got (%s)", tt.want, got)
+			continue
+		}
+		if _, err := ParseJQL(got); err != nil {
+			t.Errorf("gojira.ParseJQL(\"%s\") error: (%s)", got, err.Error())
+		}
+	}
+}
+
+func TestJQLFileYAML(t *testing.T) {
+	filename := filepath.Join(t.TempDir(), "query.yaml")
+	for _, tt := range jqlStringTests {
+		if err := tt.jql.WriteFileYAML(filename, 0600); err != nil {
+			t.Fatalf("gojira.JQL.WriteFileYAML() error: (%s)", err.Error())
+		}
+		j, err := ReadFileJQL(filename)
+		if err != nil {
+			t.Fatalf("gojira.ReadFileJQL() error: (%s)", err.Error())
+		}
+		if got := j.String(); got != tt.want {
+			t.Errorf("gojira.ReadFileJQL() mismatch: want (%s), got (%s)", tt.want, got)
+		}
+	}
+}
@@ -13,7 +13,7 @@ type JQLs []JQL
 func (jqls JQLs) JoinString(keyword string) string {
 	var parts []string
 	for _, jql := range jqls {
-		parts = append(parts, "("+jql.String()+")")
+		parts = append(parts, "("+jql.StringConditions()+")")
 	}
 	return strings.Join(parts, " "+keyword+" ")
 }
@@ -30,6 +30,8 @@ func (s *Server) CallTool(ctx context.Context, name string, args map[string]any)
 		return s.handleGetProjects(ctx, args)
 	case "jira_create_issue":
 		return s.handleCreateIssue(ctx, args)
+	case "jira_aging_wip":
+		return s.handleAgingWIP(ctx, args)
 	default:
 		return nil, fmt.Errorf("unknown tool: %s", name)
 	}
@@ -70,15 +72,21 @@ func (s *Server) handleSearch(ctx context.Context, args map[string]any) (any, er
 		}
 	}
 
-	// Use the context-aware V3 API for search
-	issues, err := s.client.IssueAPI.SearchIssuesAPIV3(ctx, jql, false)
-	if err != nil {
-		return nil, fmt.Errorf("search failed: %w", err)
+	opts := rest.SearchOptions{PageSize: maxResults}
+	if fields, ok := args["fields"].(string); ok {
+		opts.Fields = rest.ParseFieldsCSV(fields)
 	}
 
-	// Limit results
-	if len(issues) > maxResults {
-		issues = issues[:maxResults]
+	// Use the context-aware V3 API for search, stopping once maxResults are read
+	issues := rest.Issues{}
+	for iss, err := range s.client.IssueAPI.SearchIssuesAPIV3Seq(ctx, jql, opts) {
+		if err != nil {
+			return nil, fmt.Errorf("search failed: %w", err)
+		}
+		issues = append(issues, iss)
+		if len(issues) >= maxResults {
+			break
+		}
 	}
 
 	results := rest.ToIssueOutputs(issues)
@@ -178,9 +186,9 @@ func (s *Server) handleAddComment(ctx context.Context, args map[string]any) (any
 		Body: body,
 	}
 
-	addedComment, _, err := s.client.JiraClient.Issue.AddCommentWithContext(ctx, key, comment)
+	addedComment, resp, err := s.client.JiraClient.Issue.AddCommentWithContext(ctx, key, comment)
 	if err != nil {
-		return nil, fmt.Errorf("add comment to %s: %w", key, err)
+		return nil, fmt.Errorf("add comment to %s: %w", key, rest.JiraResponseError(resp, err))
 	}
 
 	return map[string]any{
@@ -228,20 +236,20 @@ func (s *Server) handleTransitionIssue(ctx context.Context, args map[string]any)
 		return nil, fmt.Errorf("transition_id is required")
 	}
 
-	_, err := s.client.JiraClient.Issue.DoTransitionWithContext(ctx, key, transitionID)
+	resp, err := s.client.JiraClient.Issue.DoTransitionWithContext(ctx, key, transitionID)
 	if err != nil {
-		return nil, fmt.Errorf("transition issue %s: %w", key, err)
+		return nil, fmt.Errorf("transition issue %s: %w", key, rest.JiraResponseError(resp, err))
 	}
 
 	// Add comment if provided
 	if comment, ok := args["comment"].(string); ok && comment != "" {
-		_, _, err := s.client.JiraClient.Issue.AddCommentWithContext(ctx, key, &jira.Comment{Body: comment})
+		_, resp, err := s.client.JiraClient.Issue.AddCommentWithContext(ctx, key, &jira.Comment{Body: comment})
 		if err != nil {
 			return map[string]any{
 				"success":       true,
 				"key":           key,
 				"transition_id": transitionID,
-				"comment_error": err.Error(),
+				"comment_error": rest.JiraResponseError(resp, err).Error(),
 				"message":       "Issue transitioned but comment failed",
 			}, nil
 		}
@@ -276,9 +284,9 @@ func (s *Server) handleGetComments(ctx context.Context, args map[string]any) (an
 }
 
 func (s *Server) handleGetProjects(ctx context.Context, _ map[string]any) (any, error) {
-	projects, _, err := s.client.JiraClient.Project.GetListWithContext(ctx)
+	projects, resp, err := s.client.JiraClient.Project.GetListWithContext(ctx)
 	if err != nil {
-		return nil, fmt.Errorf("get projects: %w", err)
+		return nil, fmt.Errorf("get projects: %w", rest.JiraResponseError(resp, err))
 	}
 
 	results := make([]map[string]any, 0, len(*projects))
@@ -374,3 +382,25 @@ func (s *Server) handleCreateIssue(ctx context.Context, args map[string]any) (an
 		"message": fmt.Sprintf("Issue %s created successfully", result.Key),
 	}, nil
 }
+
+func (s *Server) handleAgingWIP(ctx context.Context, args map[string]any) (any, error) {
+	jql, ok := args["jql"].(string)
+	if !ok || jql == "" {
+		return nil, fmt.Errorf("jql is required")
+	}
+
+	input := &core.AgingWIPInput{JQL: jql}
+	if historyJQL, ok := args["history_jql"].(string); ok {
+		input.HistoryJQL = historyJQL
+	}
+	if p, ok := args["percentile"].(float64); ok {
+		input.Percentile = int(p)
+	}
+
+	result, err := core.AgingWIP(ctx, s.client, input)
+	if err != nil {
+		return nil, fmt.Errorf("aging wip: %w", err)
+	}
+
+	return result, nil
+}
@@ -180,7 +180,7 @@ func (s *Server) handleToolsCall(ctx context.Context, req JSONRPCRequest) JSONRP
 			JSONRPC: "2.0",
 			ID:      req.ID,
 			Result: ToolCallResult{
-				Content: []ContentBlock{{Type: "text", Text: fmt.Sprintf("Error: %v", err)}},
+				Content: toolErrorContent(err),
 				IsError: true,
 			},
 		}
@@ -207,3 +207,16 @@ func (s *Server) handleToolsCall(ctx context.Context, req JSONRPCRequest) JSONRP
 		},
 	}
 }
+
+// toolErrorContent returns the content for a failed tool call. Jira API errors
+// include a JSON block with the status code, `errorMessages` and per-field
+// `errors` so the caller can see which fields Jira rejected.
+func toolErrorContent(err error) []ContentBlock {
+	content := []ContentBlock{{Type: "text", Text: fmt.Sprintf("Error: %v", err)}}
+	if apiErr, ok := rest.AsAPIError(err); ok {
+		if b, errJSON := json.MarshalIndent(map[string]any{"jiraError": apiErr}, "", "  "); errJSON == nil {
+			content = append(content, ContentBlock{Type: "text", Text: string(b)})
+		}
+	}
+	return content
+}
@@ -38,7 +38,7 @@ func GetTools() []Tool {
 					},
 					"fields": map[string]any{
 						"type":        "string",
-						"description": "Comma-separated list of fields to return (default: key,summary,status,assignee,created,updated)",
+						"description": "Comma-separated list of fields to request, e.g. 'summary,status,assignee' (default: all fields). Requesting fewer fields reduces response size.",
 					},
 				},
 				"required": []string{"jql"},
@@ -214,5 +214,28 @@ func GetTools() []Tool {
 				"required": []string{"project", "type", "summary"},
 			},
 		},
+		{
+			Name:        "jira_aging_wip",
+			Description: "Find stuck work: lists in-progress issues oldest first with their age in days in the current stage and since work started, compared to cycle-time percentiles of completed issues of the same type. Outliers are older than the percentile cycle time.",
+			InputSchema: map[string]any{
+				"type": "object",
+				"properties": map[string]any{
+					"jql": map[string]any{
+						"type":        "string",
+						"description": "JQL query for work to check (e.g., 'project = PROJ AND statusCategory = \"In Progress\"')",
+					},
+					"history_jql": map[string]any{
+						"type":        "string",
+						"description": "JQL query for completed issues used as the cycle-time baseline (default: same projects resolved in the last 180 days)",
+					},
+					"percentile": map[string]any{
+						"type":        "integer",
+						"description": "Cycle-time percentile that flags outliers: 50, 85 or 95 (default: 85)",
+						"default":     85,
+					},
+				},
+				"required": []string{"jql"},
+			},
+		},
 	}
 }
@@ -0,0 +1,199 @@
+package rest
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"sort"
+	"strings"
+	"time"
+
+	jira "github.com/andygrunwald/go-jira"
+)
+
+const apiErrorBodyLimit = 1 << 20
+
+// APIError is returned when the Jira API responds with a non-2xx status code. It carries
+// Jira's `errorMessages` and per-field `errors` from the response body. Use `errors.Is`
+// with `ErrNotFound`, `ErrUnauthorized`, `ErrPermissionDenied`, `ErrRateLimited` or
+// `ErrValidation` to classify it, and `errors.As` to access the details.
+type APIError struct {
+	StatusCode    int               `json:"statusCode"`
+	Method        string            `json:"method,omitempty"`
+	URL           string            `json:"url,omitempty"`
+	ErrorMessages []string          `json:"errorMessages,omitempty"`
+	Errors        map[string]string `json:"errors,omitempty"` // field ID or name to message
+	Body          string            `json:"body,omitempty"`   // raw body when it is not a Jira error document
+	RetryAfter    time.Duration     `json:"-"`
+	Err           error             `json:"-"`
+}
+
+// NewAPIError returns an `*APIError` for resp, parsing body as a Jira error document.
+// The request method and URL are taken from `resp.Request` if available.
+func NewAPIError(resp *http.Response, body []byte) *APIError {
+	e := &APIError{}
+	if resp == nil {
+		return e
+	}
+	e.StatusCode = resp.StatusCode
+	if resp.Request != nil {
+		e.Method = resp.Request.Method
+		if resp.Request.URL != nil {
+			e.URL = resp.Request.URL.String()
+		}
+	}
+	if d, ok := RetryAfter(resp, time.Now()); ok {
+		e.RetryAfter = d
+	}
+	doc := struct {
+		ErrorMessages []string          `json:"errorMessages"`
+		Errors        map[string]string `json:"errors"`
+	}{}
+	if err := json.Unmarshal(body, &doc); err == nil && (len(doc.ErrorMessages) > 0 || len(doc.Errors) > 0) {
+		e.ErrorMessages = doc.ErrorMessages
+		e.Errors = doc.Errors
+	} else {
+		e.Body = strings.TrimSpace(string(body))
+	}
+	return e
+}
+
+// NewAPIErrorFromResponse reads and closes the body of resp and returns an `*APIError`.
+func NewAPIErrorFromResponse(resp *http.Response) *APIError {
+	if resp == nil || resp.Body == nil {
+		return NewAPIError(resp, nil)
+	}
+	defer resp.Body.Close()
+	body, err := io.ReadAll(io.LimitReader(resp.Body, apiErrorBodyLimit))
+	e := NewAPIError(resp, body)
+	if err != nil {
+		e.Err = err
+	}
+	return e
+}
+
+// CheckResponse returns nil for 2xx responses and an `*APIError` otherwise, reading
+// and closing the response body.
+func CheckResponse(resp *http.Response) error {
+	if resp == nil {
+		return errors.New("http response cannot be nil")
+	} else if resp.StatusCode >= 200 && resp.StatusCode < 300 {
+		return nil
+	}
+	return NewAPIErrorFromResponse(resp)
+}
+
+// JiraResponseError converts an error returned by the go-jira SDK into an `*APIError`
+// when a non-2xx response is available. go-jira consumes the body, so the messages are
+// taken from its `*jira.Error`. Other errors are returned unchanged.
+func JiraResponseError(resp *jira.Response, err error) error {
+	if resp == nil || resp.Response == nil || (resp.StatusCode >= 200 && resp.StatusCode < 300) {
+		return err
+	}
+	e := NewAPIError(resp.Response, nil)
+	e.Err = err
+	var jerr *jira.Error
+	if errors.As(err, &jerr) {
+		e.ErrorMessages = jerr.ErrorMessages
+		e.Errors = jerr.Errors
+	} else if err != nil {
+		e.Body = err.Error()
+	}
+	return e
+}
+
+// Error implements the `error` interface.
+func (e *APIError) Error() string {
+	var sb strings.Builder
+	sb.WriteString("jira api error")
+	if e.Method != "" || e.URL != "" {
+		sb.WriteString(": " + strings.TrimSpace(e.Method+" "+e.URL))
+	}
+	fmt.Fprintf(&sb, ": status code (%d)", e.StatusCode)
+	if len(e.ErrorMessages) > 0 {
+		sb.WriteString(": " + strings.Join(e.ErrorMessages, "; "))
+	}
+	if fe := e.FieldErrorStrings(); len(fe) > 0 {
+		sb.WriteString(": field errors: " + strings.Join(fe, "; "))
+	}
+	if len(e.ErrorMessages) == 0 && len(e.Errors) == 0 && e.Body != "" {
+		sb.WriteString(": " + e.Body)
+	}
+	return sb.String()
+}
+
+// Unwrap returns the underlying error, if any.
+func (e *APIError) Unwrap() error { return e.Err }
+
+// Is supports `errors.Is` with `ErrNotFound`, `ErrUnauthorized`, `ErrPermissionDenied`,
+// `ErrRateLimited` and `ErrValidation`.
+func (e *APIError) Is(target error) bool {
+	switch target {
+	case ErrNotFound:
+		return e.NotFound()
+	case ErrUnauthorized:
+		return e.StatusCode == http.StatusUnauthorized
+	case ErrPermissionDenied:
+		return e.StatusCode == http.StatusForbidden
+	case ErrRateLimited:
+		return e.StatusCode == http.StatusTooManyRequests
+	case ErrValidation:
+		return e.StatusCode == http.StatusBadRequest || e.StatusCode == http.StatusUnprocessableEntity
+	default:
+		return false
+	}
+}
+
+// NotFound returns true for HTTP 404 and for HTTP 400 responses where Jira reports
+// that an issue does not exist, as search does for unknown keys.
+func (e *APIError) NotFound() bool {
+	if e.StatusCode == http.StatusNotFound {
+		return true
+	} else if e.StatusCode != http.StatusBadRequest {
+		return false
+	}
+	for _, msg := range e.ErrorMessages {
+		if strings.Contains(strings.ToLower(msg), "does not exist") {
+			return true
+		}
+	}
+	return false
+}
+
+// Retryable returns true if the request may succeed if retried.
+func (e *APIError) Retryable() bool {
+	return StatusCodeIsRetryable(e.StatusCode)
+}
+
+// FieldErrorStrings returns the per-field errors as sorted `field: message` strings.
+func (e *APIError) FieldErrorStrings() []string {
+	var out []string
+	for k, v := range e.Errors {
+		out = append(out, k+": "+v)
+	}
+	sort.Strings(out)
+	return out
+}
+
+// AsAPIError returns the `*APIError` in err's chain, if any.
+func AsAPIError(err error) (*APIError, bool) {
+	var e *APIError
+	if errors.As(err, &e) {
+		return e, true
+	}
+	return nil, false
+}
+
+// IsNotFound returns true if err is a Jira not-found error.
+func IsNotFound(err error) bool { return errors.Is(err, ErrNotFound) }
+
+// IsPermissionDenied returns true if err is a Jira HTTP 403 error.
+func IsPermissionDenied(err error) bool { return errors.Is(err, ErrPermissionDenied) }
+
+// IsRateLimited returns true if err is a Jira HTTP 429 error.
+func IsRateLimited(err error) bool { return errors.Is(err, ErrRateLimited) }
+
+// IsValidation returns true if err is a Jira validation (HTTP 400) error.
+func IsValidation(err error) bool { return errors.Is(err, ErrValidation) }
@@ -0,0 +1,101 @@
+package rest
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+var apiErrorTests = []struct {
+	name           string
+	status         int
+	body           string
+	wantNotFound   bool
+	wantPermission bool
+	wantRateLimit  bool
+	wantValidation bool
+	wantRetryable  bool
+	wantFields     int
+}{
+	{"404", http.StatusNotFound, `{"errorMessages":["Issue does not exist or you do not have permission to see it."],"errors":{}}`,
+		true, false, false, false, false, 0},
+	{"400 unknown key", http.StatusBadRequest, `{"errorMessages":["An issue with key 'ABC-999' does not exist for field 'key'."]}`,
+		true, false, false, true, false, 0},
+	{"400 field errors", http.StatusBadRequest, `{"errorMessages":[],"errors":{"summary":"You must specify a summary of the issue.","customfield_10010":"Sprint is invalid"}}`,
+		false, false, false, true, false, 2},
+	{"403", http.StatusForbidden, `{"errorMessages":["You do not have permission."]}`,
+		false, true, false, false, false, 0},
+	{"429", http.StatusTooManyRequests, ``,
+		false, false, true, false, true, 0},
+	{"503 html", http.StatusServiceUnavailable, `<html>unavailable</html>`,
+		false, false, false, false, true, 0},
+}
+
+func newAPIErrorTestServer(t *testing.T, status int, body string) *httptest.Server {
+	t.Helper()
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json;charset=UTF-8")
+		w.WriteHeader(status)
+		_, _ = w.Write([]byte(body))
+	}))
+	t.Cleanup(server.Close)
+	return server
+}
+
+func TestAPIError(t *testing.T) {
+	for _, tt := range apiErrorTests {
+		t.Run(tt.name, func(t *testing.T) {
+			server := newAPIErrorTestServer(t, tt.status, tt.body)
+			client, err := NewClientFromBearerToken(server.URL, "token", false, WithRetryPolicy(RetryPolicy{}))
+			if err != nil {
+				t.Fatalf("NewClientFromBearerToken() error = %v", err)
+			}
+
+			// go-jira stack
+			_, errSDK := client.IssueAPI.Issue(context.Background(), "ABC-1", nil)
+			// httpsimple stack
+			_, errSimple := client.IssueAPI.IssuePatch(context.Background(), "ABC-1",
+				IssuePatchRequestBody{Fields: map[string]IssuePatchRequestBodyField{"summary": {Value: ""}}})
+
+			for stack, err := range map[string]error{"IssueService.Issue()": errSDK, "IssueService.IssuePatch()": errSimple} {
+				apiErr, ok := AsAPIError(err)
+				if !ok {
+					t.Fatalf("%s error type mismatch: want (*APIError), got (%T: %v)", stack, err, err)
+				}
+				if apiErr.StatusCode != tt.status {
+					t.Errorf("%s status code mismatch: want (%d), got (%d)", stack, tt.status, apiErr.StatusCode)
+				}
+				if got := IsNotFound(err); got != tt.wantNotFound {
+					t.Errorf("%s IsNotFound() mismatch: want (%v), got (%v)", stack, tt.wantNotFound, got)
+				}
+				if got := IsPermissionDenied(err); got != tt.wantPermission {
+					t.Errorf("%s IsPermissionDenied() mismatch: want (%v), got (%v)", stack, tt.wantPermission, got)
+				}
+				if got := IsRateLimited(err); got != tt.wantRateLimit {
+					t.Errorf("%s IsRateLimited() mismatch: want (%v), got (%v)", stack, tt.wantRateLimit, got)
+				}
+				if got := IsValidation(err); got != tt.wantValidation {
+					t.Errorf("%s IsValidation() mismatch: want (%v), got (%v)", stack, tt.wantValidation, got)
+				}
+				if got := apiErr.Retryable(); got != tt.wantRetryable {
+					t.Errorf("%s Retryable() mismatch: want (%v), got (%v)", stack, tt.wantRetryable, got)
+				}
+				if got := len(apiErr.FieldErrorStrings()); got != tt.wantFields {
+					t.Errorf("%s FieldErrorStrings() count mismatch: want (%d), got (%d)", stack, tt.wantFields, got)
+				}
+			}
+		})
+	}
+}
+
+func TestAPIErrorWrapped(t *testing.T) {
+	err := errors.Join(errors.New("context"), &APIError{StatusCode: http.StatusNotFound})
+	if !IsNotFound(err) {
+		t.Errorf("IsNotFound(wrapped) mismatch: want (true), got (false)")
+	}
+	if IsNotFound(errors.New("not found")) {
+		t.Errorf("IsNotFound(plain error) mismatch: want (false), got (true)")
+	}
+}
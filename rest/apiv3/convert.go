@@ -74,6 +74,40 @@ func convertFields(v3Fields *Fields, goJiraFields *jira.IssueFields) {
 			IconURL:     v3Fields.Status.IconURL,
 			Self:        v3Fields.Status.Self,
 		}
+		if sc := v3Fields.Status.StatusCategory; sc != nil {
+			goJiraFields.Status.StatusCategory = jira.StatusCategory{
+				Self:      sc.Self,
+				ID:        sc.ID,
+				Name:      sc.Name,
+				Key:       sc.Key,
+				ColorName: sc.ColorName,
+			}
+		}
+	}
+
+	// Resolution
+	if v3Fields.Resolution != nil {
+		goJiraFields.Resolution = &jira.Resolution{
+			Self:        v3Fields.Resolution.Self,
+			ID:          v3Fields.Resolution.ID,
+			Description: v3Fields.Resolution.Description,
+			Name:        v3Fields.Resolution.Name,
+		}
+	}
+	if v3Fields.ResolutionDate != nil {
+		if dt, err := time.Parse(layoutISO8601TZNC, strings.TrimSpace(*v3Fields.ResolutionDate)); err == nil {
+			goJiraFields.Resolutiondate = jira.Time(dt)
+		}
+	}
+
+	// Remaining estimate
+	if v3Fields.TimeEstimate != nil {
+		goJiraFields.TimeEstimate = *v3Fields.TimeEstimate
+	}
+
+	// Issue links
+	for _, link := range v3Fields.IssueLinks {
+		goJiraFields.IssueLinks = append(goJiraFields.IssueLinks, convertIssueLink(link))
 	}
 
 	// Priority
@@ -127,6 +161,31 @@ func convertFields(v3Fields *Fields, goJiraFields *jira.IssueFields) {
 	}
 }
 
+// convertIssueLink converts a V3 IssueLink to a go-jira IssueLink. Linked issues include only
+// the fields returned with the link, such as summary, status and issue type.
+func convertIssueLink(link IssueLink) *jira.IssueLink {
+	out := &jira.IssueLink{
+		ID:   link.ID,
+		Self: link.Self,
+	}
+	if link.Type != nil {
+		out.Type = jira.IssueLinkType{
+			ID:      link.Type.ID,
+			Self:    link.Type.Self,
+			Name:    link.Type.Name,
+			Inward:  link.Type.Inward,
+			Outward: link.Type.Outward,
+		}
+	}
+	if link.InwardIssue != nil {
+		out.InwardIssue = link.InwardIssue.ConvertToGoJiraIssue()
+	}
+	if link.OutwardIssue != nil {
+		out.OutwardIssue = link.OutwardIssue.ConvertToGoJiraIssue()
+	}
+	return out
+}
+
 // convertUser converts a V3 User to a go-jira User
 func convertUser(v3User *User) *jira.User {
 	user := &jira.User{
@@ -110,8 +110,8 @@ func (svc *BacklogService) GetBacklogIssuesResponse(ctx context.Context, boardID
 	resp, err := svc.sclient.Do(ctx, sreq)
 	if err != nil {
 		return nil, []byte{}, err
-	} else if resp.StatusCode >= 300 {
-		return nil, []byte{}, fmt.Errorf("statusCode (%d)", resp.StatusCode)
+	} else if err := CheckResponse(resp); err != nil {
+		return nil, []byte{}, err
 	}
 	if b, err := io.ReadAll(resp.Body); err != nil {
 		return nil, []byte{}, err
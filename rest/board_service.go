@@ -0,0 +1,171 @@
+package rest
+
+import (
+	"context"
+	"strings"
+
+	jira "github.com/andygrunwald/go-jira"
+
+	"github.com/grokify/gojira"
+)
+
+// Jira Software board types.
+const (
+	BoardTypeScrum  = "scrum"
+	BoardTypeKanban = "kanban"
+	BoardTypeSimple = "simple"
+)
+
+// BoardService provides access to Jira Software boards using the Agile REST API at `/rest/agile/1.0/board`.
+type BoardService struct {
+	Client *Client
+}
+
+func NewBoardService(client *Client) *BoardService {
+	return &BoardService{Client: client}
+}
+
+// BoardListOptions filters boards. All fields are optional.
+type BoardListOptions struct {
+	ProjectKeyOrID string
+	Type           string // `BoardTypeScrum`, `BoardTypeKanban` or `BoardTypeSimple`
+	Name           string // boards whose name contains this value
+}
+
+// BoardColumn is a board column with the names of the statuses mapped to it.
+type BoardColumn struct {
+	Name      string   `json:"name"`
+	Statuses  []string `json:"statuses"`
+	StatusIDs []string `json:"statusIds"`
+	Min       int      `json:"min,omitempty"`
+	Max       int      `json:"max,omitempty"`
+}
+
+// Boards returns all boards matching the options, reading every page.
+func (svc *BoardService) Boards(ctx context.Context, opts *BoardListOptions) ([]jira.Board, error) {
+	if svc.Client == nil || svc.Client.JiraClient == nil {
+		return nil, ErrJiraClientCannotBeNil
+	}
+	jopts := &jira.BoardListOptions{SearchOptions: jira.SearchOptions{MaxResults: MaxResults}}
+	if opts != nil {
+		jopts.ProjectKeyOrID = strings.TrimSpace(opts.ProjectKeyOrID)
+		jopts.BoardType = strings.ToLower(strings.TrimSpace(opts.Type))
+		jopts.Name = strings.TrimSpace(opts.Name)
+	}
+	var out []jira.Board
+	for {
+		list, resp, err := svc.Client.JiraClient.Board.GetAllBoardsWithContext(ctx, jopts)
+		if err != nil {
+			return nil, JiraResponseError(resp, err)
+		}
+		out = append(out, list.Values...)
+		jopts.StartAt += len(list.Values)
+		if list.IsLast || len(list.Values) == 0 {
+			return out, nil
+		}
+	}
+}
+
+// Board returns a board by ID.
+func (svc *BoardService) Board(ctx context.Context, boardID int) (*jira.Board, error) {
+	if svc.Client == nil || svc.Client.JiraClient == nil {
+		return nil, ErrJiraClientCannotBeNil
+	}
+	board, resp, err := svc.Client.JiraClient.Board.GetBoardWithContext(ctx, boardID)
+	if err != nil {
+		return nil, JiraResponseError(resp, err)
+	}
+	return board, nil
+}
+
+// Configuration returns a board's configuration, including its filter and column configuration.
+func (svc *BoardService) Configuration(ctx context.Context, boardID int) (*jira.BoardConfiguration, error) {
+	if svc.Client == nil || svc.Client.JiraClient == nil {
+		return nil, ErrJiraClientCannotBeNil
+	}
+	cfg, resp, err := svc.Client.JiraClient.Board.GetBoardConfigurationWithContext(ctx, boardID)
+	if err != nil {
+		return nil, JiraResponseError(resp, err)
+	}
+	return cfg, nil
+}
+
+// Columns returns a board's columns in order, with status names read from the server.
+func (svc *BoardService) Columns(ctx context.Context, boardID int) ([]BoardColumn, error) {
+	cfg, err := svc.Configuration(ctx, boardID)
+	if err != nil {
+		return nil, err
+	}
+	statuses, resp, err := svc.Client.JiraClient.Status.GetAllStatusesWithContext(ctx)
+	if err != nil {
+		return nil, JiraResponseError(resp, err)
+	}
+	return NewBoardColumns(cfg, statuses), nil
+}
+
+// StatusCategoryConfig returns a `gojira.StatusCategoryConfig` with a meta stage per board column.
+// See `NewStatusCategoryConfigFromBoardColumns`.
+func (svc *BoardService) StatusCategoryConfig(ctx context.Context, boardID int) (*gojira.StatusCategoryConfig, error) {
+	cfg, err := svc.Configuration(ctx, boardID)
+	if err != nil {
+		return nil, err
+	}
+	statuses, resp, err := svc.Client.JiraClient.Status.GetAllStatusesWithContext(ctx)
+	if err != nil {
+		return nil, JiraResponseError(resp, err)
+	}
+	return NewStatusCategoryConfigFromBoardColumns(NewBoardColumns(cfg, statuses), statuses)
+}
+
+// NewBoardColumns maps a board configuration's columns to status names. Statuses not in
+// `statuses` are listed by ID only.
+func NewBoardColumns(cfg *jira.BoardConfiguration, statuses []jira.Status) []BoardColumn {
+	if cfg == nil {
+		return nil
+	}
+	names := map[string]string{}
+	for _, st := range statuses {
+		names[st.ID] = st.Name
+	}
+	var out []BoardColumn
+	for _, col := range cfg.ColumnConfig.Columns {
+		bc := BoardColumn{Name: col.Name, Statuses: []string{}, StatusIDs: []string{}, Min: col.Min, Max: col.Max}
+		for _, st := range col.Status {
+			bc.StatusIDs = append(bc.StatusIDs, st.ID)
+			if name, ok := names[st.ID]; ok {
+				bc.Statuses = append(bc.Statuses, name)
+			}
+		}
+		out = append(out, bc)
+	}
+	return out
+}
+
+// Sprints returns a board's sprints, reading every page. `states` filters by `SprintStateActive`,
+// `SprintStateFuture` or `SprintStateClosed`; none returns all sprints.
+func (svc *BoardService) Sprints(ctx context.Context, boardID int, states ...string) ([]jira.Sprint, error) {
+	if svc.Client == nil || svc.Client.JiraClient == nil {
+		return nil, ErrJiraClientCannotBeNil
+	}
+	var lc []string
+	for _, s := range states {
+		if s = strings.ToLower(strings.TrimSpace(s)); s != "" {
+			lc = append(lc, s)
+		}
+	}
+	jopts := &jira.GetAllSprintsOptions{
+		State:         strings.Join(lc, ","),
+		SearchOptions: jira.SearchOptions{MaxResults: MaxResults}}
+	var out []jira.Sprint
+	for {
+		list, resp, err := svc.Client.JiraClient.Board.GetAllSprintsWithOptionsWithContext(ctx, boardID, jopts)
+		if err != nil {
+			return nil, JiraResponseError(resp, err)
+		}
+		out = append(out, list.Values...)
+		jopts.StartAt += len(list.Values)
+		if list.IsLast || len(list.Values) == 0 {
+			return out, nil
+		}
+	}
+}
@@ -0,0 +1,96 @@
+package rest
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+
+	jira "github.com/andygrunwald/go-jira"
+)
+
+// VelocityOptions configures `BoardService.Velocity`.
+type VelocityOptions struct {
+	Sprints      int      // most recent closed sprints; 0 uses `VelocitySprintsDefault`
+	Window       int      // rolling average window; 0 uses `VelocityWindowDefault`
+	PointsField  string   // story points field name or ID; empty uses `CustomFieldNameStoryPoints`
+	DoneStatuses []string // empty uses the client `StatusCategoryConfig`, or else Jira status categories
+}
+
+// Velocity returns the velocity of a board's most recent closed sprints, ordered by completion.
+// The story points field is resolved with the client's `CustomFieldSet`, which is loaded if not set.
+func (svc *BoardService) Velocity(ctx context.Context, boardID int, opts VelocityOptions) (*VelocityReport, error) {
+	if svc.Client == nil || svc.Client.JiraClient == nil {
+		return nil, ErrJiraClientCannotBeNil
+	}
+	if opts.Sprints <= 0 {
+		opts.Sprints = VelocitySprintsDefault
+	}
+	cfs := svc.Client.CustomFieldSet
+	if cfs == nil || len(cfs.Data) == 0 {
+		var err error
+		if cfs, err = svc.Client.CustomFieldAPI.GetCustomFieldSet(); err != nil {
+			return nil, err
+		}
+	}
+	pointsField := strings.TrimSpace(opts.PointsField)
+	if pointsField == "" {
+		pointsField = CustomFieldNameStoryPoints
+	}
+	cf, err := cfs.FieldByNameOrID(pointsField)
+	if err != nil {
+		return nil, err
+	}
+	bopts := BurndownOptions{PointsField: cf.ID, PointsFieldName: cf.Name, DoneStatuses: opts.DoneStatuses}
+	if len(bopts.DoneStatuses) == 0 && (svc.Client.Config == nil || svc.Client.Config.StatusConfig == nil) {
+		scc, err := svc.Client.StatusCategoryConfigFromJiraCategories(ctx, nil)
+		if err != nil {
+			return nil, err
+		}
+		bopts.DoneStatuses = scc.StatusesDone()
+	}
+
+	sprints, err := svc.Sprints(ctx, boardID, SprintStateClosed)
+	if err != nil {
+		return nil, err
+	}
+	sort.SliceStable(sprints, func(i, j int) bool { return sprintCompleteTime(sprints[i]).Before(sprintCompleteTime(sprints[j])) })
+	if len(sprints) > opts.Sprints {
+		sprints = sprints[len(sprints)-opts.Sprints:]
+	}
+
+	var svs []SprintVelocity
+	for _, sprint := range sprints {
+		if sprint.StartDate == nil {
+			continue
+		}
+		set, err := svc.Client.SprintAPI.IssuesSet(ctx, sprint.ID, SearchOptions{
+			Fields: []string{"status", "created", "resolutiondate", "timeestimate", cf.ID},
+			Expand: []string{ExpandFieldChangelog}})
+		if err != nil {
+			return nil, err
+		} else if err := svc.Client.IssueAPI.IssuesSetLoadChangelogs(ctx, set); err != nil {
+			return nil, err
+		}
+		sv, err := set.SprintVelocity(sprint, bopts)
+		if err != nil {
+			return nil, fmt.Errorf("sprint velocity (%d): %w", sprint.ID, err)
+		}
+		svs = append(svs, *sv)
+	}
+	r := NewVelocityReport(svs, opts.Window)
+	r.BoardID = boardID
+	r.PointsField = cf.Name
+	return r, nil
+}
+
+// sprintCompleteTime returns the complete date, or else the end date, of a sprint.
+func sprintCompleteTime(sprint jira.Sprint) time.Time {
+	if sprint.CompleteDate != nil {
+		return *sprint.CompleteDate
+	} else if sprint.EndDate != nil {
+		return *sprint.EndDate
+	}
+	return time.Time{}
+}
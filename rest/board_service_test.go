@@ -0,0 +1,19 @@
+package rest
+
+import (
+	"strings"
+	"testing"
+
+	jira "github.com/andygrunwald/go-jira"
+)
+
+func TestNewBoardColumns(t *testing.T) {
+	cfg := &jira.BoardConfiguration{ColumnConfig: jira.BoardConfigurationColumnConfig{Columns: []jira.BoardConfigurationColumn{
+		{Name: "To Do", Status: []jira.BoardConfigurationColumnStatus{{ID: "1"}}},
+		{Name: "Doing", Status: []jira.BoardConfigurationColumnStatus{{ID: "3"}, {ID: "4"}}, Max: 5}}}}
+	cols := NewBoardColumns(cfg, []jira.Status{{ID: "1", Name: "Open"}, {ID: "3", Name: "In Progress"}})
+	if len(cols) != 2 || cols[1].Name != "Doing" || cols[1].Max != 5 ||
+		strings.Join(cols[1].Statuses, ",") != "In Progress" || strings.Join(cols[1].StatusIDs, ",") != "3,4" {
+		t.Errorf("NewBoardColumns() mismatch: want (Doing [In Progress] [3 4] max 5), got (%v)", cols)
+	}
+}
@@ -3,8 +3,10 @@ package rest
 import (
 	"context"
 	"errors"
+	"fmt"
 	"log/slog"
 	"net/http"
+	"strings"
 
 	jira "github.com/andygrunwald/go-jira"
 	"github.com/grokify/goauth"
@@ -25,67 +27,130 @@ type Client struct {
 	simpleClient   *httpsimple.Client
 	Logger         *slog.Logger
 	BacklogAPI     *BacklogService
+	BoardAPI       *BoardService
 	CreateMetaAPI  *CreateMetaService
 	CustomFieldAPI *CustomFieldService
 	IssueAPI       *IssueService
+	SprintAPI      *SprintService
 	CustomFieldSet *CustomFieldSet
+	RateLimiter    *RateLimiter // shared by HTTPClient and JiraClient; nil if not configured
 }
 
 // NewClientFromBasicAuth creates a new Client using basic authentication.
 // If addCustomFieldSet is true, custom fields are loaded during initialization.
-func NewClientFromBasicAuth(serverURL, username, password string, addCustomFieldSet bool) (*Client, error) {
+func NewClientFromBasicAuth(serverURL, username, password string, addCustomFieldSet bool, opts ...ClientOption) (*Client, error) {
 	if hclient, err := authutil.NewClientBasicAuth(username, password, false); err != nil {
 		return nil, err
-	} else if jclient, err := JiraClientBasicAuth(serverURL, username, password); err != nil {
-		return nil, err
 	} else {
-		return newClientFromClients(hclient, jclient, serverURL, addCustomFieldSet)
+		tp := jira.BasicAuthTransport{
+			Username: username,
+			Password: password}
+		return newClientFromHTTPClients(hclient, tp.Client(), serverURL, addCustomFieldSet, opts...)
+	}
+}
+
+// NewClientFromBearerToken creates a new Client that sends `Authorization: Bearer <token>`.
+// This is used for Jira Server/Data Center Personal Access Tokens (PATs) when basic
+// authentication is disabled. If addCustomFieldSet is true, custom fields are loaded
+// during initialization.
+func NewClientFromBearerToken(serverURL, token string, addCustomFieldSet bool, opts ...ClientOption) (*Client, error) {
+	if token = strings.TrimSpace(token); token == "" {
+		return nil, ErrBearerTokenCannotBeEmpty
 	}
+	hclient := authutil.NewClientToken(authutil.TokenBearer, token, false)
+	tp := jira.PATAuthTransport{Token: token}
+	return newClientFromHTTPClients(hclient, tp.Client(), serverURL, addCustomFieldSet, opts...)
 }
 
 // NewClientFromGoauthCLI creates a new Client by interactively selecting credentials
 // from the goauth CLI. If inclAccountsOnError is true, available accounts are shown
 // when an error occurs during selection.
-func NewClientFromGoauthCLI(inclAccountsOnError, addCustomFieldSet bool) (*Client, error) {
+func NewClientFromGoauthCLI(inclAccountsOnError, addCustomFieldSet bool, opts ...ClientOption) (*Client, error) {
 	if creds, err := goauth.NewCredentialsFromCLI(inclAccountsOnError); err != nil {
 		return nil, err
 	} else {
-		return NewClientFromGoauthCredentials(&creds, addCustomFieldSet)
+		return NewClientFromGoauthCredentials(&creds, addCustomFieldSet, opts...)
 	}
 }
 
 // NewClientFromGoauthCredentials creates a new Client from goauth credentials.
-// Currently only basic authentication is supported.
-func NewClientFromGoauthCredentials(c *goauth.Credentials, addCustomFieldSet bool) (*Client, error) {
+// Supported credentials are basic authentication and bearer tokens. Bearer tokens
+// are read from `oauth2.token.access_token` with `oauth2.serverURL`, or from an
+// `Authorization: Bearer` header in `headerquery` credentials.
+func NewClientFromGoauthCredentials(c *goauth.Credentials, addCustomFieldSet bool, opts ...ClientOption) (*Client, error) {
 	if c == nil {
 		return nil, errors.New("goauth.Credentials cannot be nil")
 	} else if c.Type == goauth.TypeBasic && c.Basic != nil {
-		return NewClientFromBasicAuth(c.Basic.ServerURL, c.Basic.Username, c.Basic.Password, addCustomFieldSet)
+		return NewClientFromBasicAuth(c.Basic.ServerURL, c.Basic.Username, c.Basic.Password, addCustomFieldSet, opts...)
+	} else if serverURL, token, ok := GoauthCredentialsBearerToken(c); ok {
+		return NewClientFromBearerToken(serverURL, token, addCustomFieldSet, opts...)
 	} else {
 		return nil, errors.New("auth method not supported or populated")
 	}
 }
 
+// GoauthCredentialsBearerToken returns the server URL and bearer token from goauth
+// credentials of type `oauth2` with a populated token, or of type `headerquery`
+// with an `Authorization: Bearer` header.
+func GoauthCredentialsBearerToken(c *goauth.Credentials) (serverURL, token string, ok bool) {
+	if c == nil {
+		return "", "", false
+	}
+	switch c.Type {
+	case goauth.TypeOAuth2:
+		if c.OAuth2 != nil && c.OAuth2.Token != nil {
+			serverURL, token = c.OAuth2.ServerURL, strings.TrimSpace(c.OAuth2.Token.AccessToken)
+		}
+	case goauth.TypeHeaderQuery:
+		if c.HeaderQuery != nil {
+			authz := strings.TrimSpace(c.HeaderQuery.Header.Get("Authorization"))
+			if prefix := authutil.TokenBearer + " "; len(authz) > len(prefix) && strings.EqualFold(authz[:len(prefix)], prefix) {
+				serverURL, token = c.HeaderQuery.ServerURL, strings.TrimSpace(authz[len(prefix):])
+			}
+		}
+	}
+	serverURL = strings.TrimSpace(serverURL)
+	return serverURL, token, serverURL != "" && token != ""
+}
+
 // NewClientGoauthCredentialsSetFile creates a new Client from a goauth credentials file.
 // The accountkey specifies which account to use from the credentials set.
-func NewClientGoauthCredentialsSetFile(filename, accountkey string, addCustomFieldSet, inclAccountsOnError bool) (*Client, error) {
+func NewClientGoauthCredentialsSetFile(filename, accountkey string, addCustomFieldSet, inclAccountsOnError bool, opts ...ClientOption) (*Client, error) {
 	if creds, err := goauth.NewCredentialsFromSetFile(filename, accountkey, inclAccountsOnError); err != nil {
 		return nil, err
-	} else if client, err := NewClientFromGoauthCredentials(&creds, addCustomFieldSet); err != nil {
+	} else if client, err := NewClientFromGoauthCredentials(&creds, addCustomFieldSet, opts...); err != nil {
 		return nil, err
 	} else {
 		return client, nil
 	}
 }
 
-func newClientFromClients(hclient *http.Client, jclient *jira.Client, serverURL string, addCustomFieldSet bool) (*Client, error) {
+// newClientFromHTTPClients builds a Client from an HTTP client used for custom
+// requests and an HTTP client used by go-jira. Both transports are wrapped
+// according to opts, sharing one `RateLimiter`, before the go-jira client is created.
+func newClientFromHTTPClients(hclient, jhclient *http.Client, serverURL string, addCustomFieldSet bool, opts ...ClientOption) (*Client, error) {
+	o := newClientOptions(opts...)
+	cfg := o.Config(serverURL)
+	retryPolicy := o.RetryPolicy(cfg)
+	limiter := o.RateLimiter(cfg)
+	wrapHTTPClient(hclient, retryPolicy, limiter)
+	wrapHTTPClient(jhclient, retryPolicy, limiter)
+	if jclient, err := jira.NewClient(jhclient, serverURL); err != nil {
+		return nil, err
+	} else if c, err := newClientFromClients(hclient, jclient, cfg, addCustomFieldSet); err != nil {
+		return nil, err
+	} else {
+		c.RateLimiter = limiter
+		return c, nil
+	}
+}
+
+func newClientFromClients(hclient *http.Client, jclient *jira.Client, cfg *gojira.Config, addCustomFieldSet bool) (*Client, error) {
 	c := &Client{
+		Config:     cfg,
 		HTTPClient: hclient,
 		JiraClient: jclient}
-	cfg := gojira.NewConfigDefault()
-	cfg.ServerURL = serverURL
-	c.Config = cfg
-	sc := httpsimple.NewClient(hclient, serverURL)
+	sc := httpsimple.NewClient(hclient, cfg.ServerURL)
 	c.simpleClient = &sc
 	if err := c.Inflate(addCustomFieldSet); err != nil {
 		return nil, err
@@ -96,13 +161,13 @@ func newClientFromClients(hclient *http.Client, jclient *jira.Client, serverURL
 // NewClientGoauthBasicAuthFile creates a new Client from a goauth credentials file
 // using basic authentication. This is the recommended way to create a client when
 // credentials are stored in a file.
-func NewClientGoauthBasicAuthFile(filename, credsKey string, addCustomFieldSet bool) (*Client, error) {
-	if hclient, serverURL, err := NewClientHTTPBasicAuthFile(filename, credsKey); err != nil {
-		return nil, errorsutil.Wrapf(err, `rest.ClientsBasicAuthFile() (%s)`, filename)
-	} else if jclient, err := NewClientJiraBasicAuthFile(filename, credsKey); err != nil {
-		return nil, errorsutil.Wrap(err, `rest.ClientsBasicAuthFile()..JiraClientBasicAuthFile()`)
+func NewClientGoauthBasicAuthFile(filename, credsKey string, addCustomFieldSet bool, opts ...ClientOption) (*Client, error) {
+	if creds, err := NewCredentialsBasicAuthGoauthFile(filename, credsKey); err != nil {
+		return nil, errorsutil.Wrapf(err, `rest.NewClientGoauthBasicAuthFile() (%s)`, filename)
+	} else if creds == nil {
+		return nil, fmt.Errorf("basic auth credentials not found for key (%s) in file (%s)", credsKey, filename)
 	} else {
-		return newClientFromClients(hclient, jclient, serverURL, addCustomFieldSet)
+		return NewClientFromBasicAuth(creds.ServerURL, creds.Username, creds.Password, addCustomFieldSet, opts...)
 	}
 }
 
@@ -163,13 +228,15 @@ func JiraClientBasicAuthGoauth(creds *goauth.CredentialsBasicAuth) (*jira.Client
 	return JiraClientBasicAuth(creds.ServerURL, creds.Username, creds.Password)
 }
 
-// Inflate initializes the client's service APIs (BacklogAPI, CreateMetaAPI, CustomFieldAPI, IssueAPI).
+// Inflate initializes the client's service APIs (BacklogAPI, BoardAPI, CreateMetaAPI, CustomFieldAPI, IssueAPI, SprintAPI).
 // If addCustomFieldSet is true, custom fields are loaded from the Jira server.
 func (c *Client) Inflate(addCustomFieldSet bool) error {
 	c.BacklogAPI = NewBacklogService(c)
+	c.BoardAPI = NewBoardService(c)
 	c.CreateMetaAPI = NewCreateMetaService(c)
 	c.CustomFieldAPI = NewCustomFieldService(c)
 	c.IssueAPI = NewIssueService(c)
+	c.SprintAPI = NewSprintService(c)
 	if addCustomFieldSet {
 		if err := c.LoadCustomFields(); err != nil {
 			return err
@@ -200,3 +267,19 @@ func (c *Client) LogOrNotAny(ctx context.Context, level slog.Level, msg string,
 		slogutil.LogOrNotAny(ctx, c.Logger, level, msg, attrs...)
 	}
 }
+
+// doJSON sends a request to a Jira API endpoint relative to the server URL. A non-nil body is sent
+// as JSON and a non-nil v is decoded from the JSON response.
+func (c *Client) doJSON(ctx context.Context, method, apiEndpoint string, body, v any) error {
+	if c == nil || c.JiraClient == nil {
+		return ErrJiraClientCannotBeNil
+	}
+	req, err := c.JiraClient.NewRequestWithContext(ctx, method, apiEndpoint, body)
+	if err != nil {
+		return err
+	}
+	if resp, err := c.JiraClient.Do(req, v); err != nil {
+		return JiraResponseError(resp, err)
+	}
+	return nil
+}
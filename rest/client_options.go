@@ -0,0 +1,95 @@
+package rest
+
+import (
+	"net/http"
+
+	"github.com/grokify/gojira"
+)
+
+// ClientOption configures a Client created by one of the NewClient* functions.
+type ClientOption func(*clientOptions)
+
+type clientOptions struct {
+	config      *gojira.Config
+	retryPolicy *RetryPolicy
+	rateLimiter *RateLimiter
+}
+
+func newClientOptions(opts ...ClientOption) clientOptions {
+	o := clientOptions{}
+	for _, opt := range opts {
+		if opt != nil {
+			opt(&o)
+		}
+	}
+	return o
+}
+
+// WithConfig sets the `gojira.Config` used by the client. The config is copied, and the
+// copy's `ServerURL` is set to the server URL supplied to the constructor.
+func WithConfig(cfg *gojira.Config) ClientOption {
+	return func(o *clientOptions) {
+		o.config = cfg
+	}
+}
+
+// WithRetryPolicy overrides the retry settings derived from `gojira.Config`.
+// Use `RetryPolicy{}` to disable retries.
+func WithRetryPolicy(p RetryPolicy) ClientOption {
+	return func(o *clientOptions) {
+		o.retryPolicy = &p
+	}
+}
+
+// WithRateLimiter sets the `RateLimiter` shared by the client's HTTP stacks,
+// overriding the rate limit settings on `gojira.Config`. Passing the same
+// limiter to multiple clients shares the rate across them.
+func WithRateLimiter(l *RateLimiter) ClientOption {
+	return func(o *clientOptions) {
+		o.rateLimiter = l
+	}
+}
+
+// Config returns the `gojira.Config` for the client, using `gojira.NewConfigDefault()`
+// if none was supplied. A supplied config is copied so the caller's is not changed.
+func (o clientOptions) Config(serverURL string) *gojira.Config {
+	var cfg *gojira.Config
+	if o.config != nil {
+		c := *o.config
+		cfg = &c
+	} else {
+		cfg = gojira.NewConfigDefault()
+	}
+	cfg.ServerURL = serverURL
+	return cfg
+}
+
+// RetryPolicy returns the explicit `RetryPolicy` if set, otherwise one built from cfg.
+func (o clientOptions) RetryPolicy(cfg *gojira.Config) RetryPolicy {
+	if o.retryPolicy != nil {
+		return *o.retryPolicy
+	}
+	return NewRetryPolicy(cfg)
+}
+
+// RateLimiter returns the explicit `RateLimiter` if set, otherwise one built from cfg.
+// Returns nil if rate limiting is not configured.
+func (o clientOptions) RateLimiter(cfg *gojira.Config) *RateLimiter {
+	if o.rateLimiter != nil {
+		return o.rateLimiter
+	}
+	return NewRateLimiterFromConfig(cfg)
+}
+
+// wrapHTTPClient installs rate limiting and retry transports on hclient. Retries
+// wrap the limiter so that each attempt waits for the shared rate.
+func wrapHTTPClient(hclient *http.Client, p RetryPolicy, l *RateLimiter) {
+	if hclient == nil {
+		return
+	}
+	tr := hclient.Transport
+	if l != nil {
+		tr = NewRateLimitTransport(tr, l)
+	}
+	hclient.Transport = NewRetryTransport(tr, p)
+}
@@ -0,0 +1,100 @@
+package rest
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/grokify/goauth"
+	"github.com/grokify/gojira"
+	"golang.org/x/oauth2"
+)
+
+func TestNewClientFromBearerToken(t *testing.T) {
+	const token = "pat-123"
+	want := "Bearer " + token
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if got := r.Header.Get("Authorization"); got != want {
+			t.Errorf("%s %s Authorization mismatch: want (%s), got (%s)", r.Method, r.URL.Path, want, got)
+		}
+		w.Header().Set("Content-Type", "application/json")
+		switch r.URL.Path {
+		case "/rest/api/2/issue/ABC-1":
+			_, _ = w.Write([]byte(`{"id":"10001","key":"ABC-1","fields":{"summary":"hello"}}`))
+		case APIURLMyself:
+			_, _ = w.Write([]byte(`{"name":"jdoe","displayName":"Jane Doe"}`))
+		default:
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+	defer server.Close()
+
+	client, err := NewClientFromBearerToken(server.URL, token, false, WithRetryPolicy(RetryPolicy{}))
+	if err != nil {
+		t.Fatalf("NewClientFromBearerToken() error = %v", err)
+	}
+	ctx := context.Background()
+
+	// go-jira stack
+	iss, err := client.IssueAPI.Issue(ctx, "ABC-1", nil)
+	if err != nil {
+		t.Fatalf("IssueService.Issue() error = %v", err)
+	} else if iss.Key != "ABC-1" {
+		t.Errorf("IssueService.Issue() key mismatch: want (ABC-1), got (%s)", iss.Key)
+	}
+
+	// httpsimple stack
+	if _, _, err := client.Myself(ctx); err != nil {
+		t.Errorf("Client.Myself() error = %v", err)
+	}
+
+	if _, err := NewClientFromBearerToken(server.URL, " ", false); err != ErrBearerTokenCannotBeEmpty {
+		t.Errorf("NewClientFromBearerToken() empty token error mismatch: want (%v), got (%v)", ErrBearerTokenCannotBeEmpty, err)
+	}
+}
+
+func TestGoauthCredentialsBearerToken(t *testing.T) {
+	tests := []struct {
+		name      string
+		creds     *goauth.Credentials
+		wantURL   string
+		wantToken string
+		wantOK    bool
+	}{
+		{"oauth2", &goauth.Credentials{Type: goauth.TypeOAuth2, OAuth2: &goauth.CredentialsOAuth2{
+			ServerURL: "https://jira.example.com", Token: &oauth2.Token{AccessToken: "abc"}}},
+			"https://jira.example.com", "abc", true},
+		{"headerquery", &goauth.Credentials{Type: goauth.TypeHeaderQuery, HeaderQuery: &goauth.CredentialsHeaderQuery{
+			ServerURL: "https://jira.example.com", Header: http.Header{"Authorization": {"bearer xyz"}}}},
+			"https://jira.example.com", "xyz", true},
+		{"headerquery basic", &goauth.Credentials{Type: goauth.TypeHeaderQuery, HeaderQuery: &goauth.CredentialsHeaderQuery{
+			ServerURL: "https://jira.example.com", Header: http.Header{"Authorization": {"Basic xyz"}}}},
+			"", "", false},
+		{"oauth2 no server", &goauth.Credentials{Type: goauth.TypeOAuth2, OAuth2: &goauth.CredentialsOAuth2{
+			Token: &oauth2.Token{AccessToken: "abc"}}},
+			"", "abc", false},
+		{"nil", nil, "", "", false},
+	}
+	for _, tt := range tests {
+		serverURL, token, ok := GoauthCredentialsBearerToken(tt.creds)
+		if serverURL != tt.wantURL || token != tt.wantToken || ok != tt.wantOK {
+			t.Errorf("GoauthCredentialsBearerToken(%s) mismatch: want (%s, %s, %v), got (%s, %s, %v)",
+				tt.name, tt.wantURL, tt.wantToken, tt.wantOK, serverURL, token, ok)
+		}
+	}
+}
+
+func TestNewClientFromBearerTokenConfig(t *testing.T) {
+	cfg := gojira.NewConfigDefault()
+	client, err := NewClientFromBearerToken("https://jira.example.com", "pat-123", false, WithConfig(cfg))
+	if err != nil {
+		t.Fatalf("NewClientFromBearerToken() error = %v", err)
+	}
+	if client.Config.ServerURL != "https://jira.example.com" {
+		t.Errorf("Client.Config.ServerURL mismatch: want (%s), got (%s)", "https://jira.example.com", client.Config.ServerURL)
+	}
+	if cfg.ServerURL != "" {
+		t.Errorf("WithConfig() caller config ServerURL mismatch: want (%s), got (%s)", "", cfg.ServerURL)
+	}
+}
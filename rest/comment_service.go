@@ -15,11 +15,11 @@ func (c *Client) GetComments(ctx context.Context, issueKey string, maxResults in
 	}
 
 	// Get issue with renderedFields expansion to include comments
-	issue, _, err := c.JiraClient.Issue.GetWithContext(ctx, issueKey, &jira.GetQueryOptions{
+	issue, resp, err := c.JiraClient.Issue.GetWithContext(ctx, issueKey, &jira.GetQueryOptions{
 		Expand: "renderedFields",
 	})
 	if err != nil {
-		return nil, fmt.Errorf("get issue %s: %w", issueKey, err)
+		return nil, fmt.Errorf("get issue %s: %w", issueKey, JiraResponseError(resp, err))
 	}
 
 	response := &CommentsResponse{
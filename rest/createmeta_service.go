@@ -50,8 +50,8 @@ func (svc *CreateMetaService) GetIssueTypes(ctx context.Context, projectKey stri
 	}
 	defer resp.Body.Close()
 
-	if resp.StatusCode >= 300 {
-		return nil, fmt.Errorf("error status code (%d) for project %q", resp.StatusCode, projectKey)
+	if err := CheckResponse(resp); err != nil {
+		return nil, fmt.Errorf("project %q: %w", projectKey, err)
 	}
 
 	var result CreateMetaIssueTypesResponse
@@ -93,8 +93,8 @@ func (svc *CreateMetaService) GetFields(ctx context.Context, projectKey, issueTy
 	}
 	defer resp.Body.Close()
 
-	if resp.StatusCode >= 300 {
-		return nil, fmt.Errorf("error status code (%d) for project %q, issue type %q", resp.StatusCode, projectKey, issueTypeID)
+	if err := CheckResponse(resp); err != nil {
+		return nil, fmt.Errorf("project %q, issue type %q: %w", projectKey, issueTypeID, err)
 	}
 
 	var result CreateMetaFieldsResponse
@@ -4,6 +4,7 @@ import (
 	"errors"
 	"io"
 	"sort"
+	"strconv"
 	"strings"
 
 	jira "github.com/andygrunwald/go-jira"
@@ -16,7 +17,8 @@ import (
 )
 
 const (
-	CustomFieldNameEpicLink = "Epic Link"
+	CustomFieldNameEpicLink    = "Epic Link"
+	CustomFieldNameStoryPoints = "Story Points"
 )
 
 var ErrJiraRESTClientCannotBeNil = errors.New("rest.Client cannot be nil")
@@ -172,6 +174,25 @@ func IssueFieldsCustomFieldString(fields *jira.IssueFields, id string) string {
 	return strings.TrimSpace(val)
 }
 
+// IssueFieldsCustomFieldFloat returns a number custom field, e.g "Story Points". The bool is false
+// if the field is not set or is not a number.
+func IssueFieldsCustomFieldFloat(fields *jira.IssueFields, id string) (float64, bool) {
+	if fields == nil {
+		return 0, false
+	}
+	switch v := fields.Unknowns[id].(type) {
+	case float64:
+		return v, true
+	case int:
+		return float64(v), true
+	case string:
+		f, err := strconv.ParseFloat(strings.TrimSpace(v), 64)
+		return f, err == nil
+	default:
+		return 0, false
+	}
+}
+
 // GetCustomValueString attempts to return a string if either the custom value is a simple string
 // or is an `IssueCustomField`, in which case it returns the `value` property.
 func GetCustomValueString(iss jira.Issue, customFieldKey string) (string, error) {
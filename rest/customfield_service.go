@@ -32,8 +32,8 @@ func (svc *CustomFieldService) GetCustomFields() (CustomFields, error) {
 	if err != nil {
 		return cfs, err
 	}
-	if resp.StatusCode >= 300 {
-		return cfs, fmt.Errorf("error status code (%d)", resp.StatusCode)
+	if err := CheckResponse(resp); err != nil {
+		return cfs, err
 	}
 	_, err = jsonutil.UnmarshalReader(resp.Body, &cfs)
 	return cfs, err
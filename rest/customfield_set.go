@@ -2,6 +2,8 @@ package rest
 
 import (
 	"errors"
+	"fmt"
+	"sort"
 	"strings"
 
 	jira "github.com/andygrunwald/go-jira"
@@ -84,6 +86,31 @@ func (set *CustomFieldSet) NameToFields(name string) []CustomField {
 	return fields
 }
 
+// FieldByNameOrID returns the custom field with the ID, e.g. "customfield_10016", or the only
+// custom field with the name. An error is returned if no field or more than one field matches.
+func (set *CustomFieldSet) FieldByNameOrID(nameOrID string) (CustomField, error) {
+	if set == nil || set.Data == nil {
+		return CustomField{}, errors.New("custom field set is empty")
+	}
+	if id, ok := gojira.IsCustomFieldKey(nameOrID); ok {
+		if cf, ok := set.Data[id]; ok {
+			return cf, nil
+		}
+		return CustomField{}, fmt.Errorf("custom field not found: %s", nameOrID)
+	}
+	fields := set.NameToFields(nameOrID)
+	switch len(fields) {
+	case 0:
+		return CustomField{}, fmt.Errorf("custom field not found: %q", nameOrID)
+	case 1:
+		return fields[0], nil
+	default:
+		ids := set.NameToIDs(nameOrID)
+		sort.Strings(ids)
+		return CustomField{}, fmt.Errorf("multiple custom fields found with name %q (%s); use a field ID", nameOrID, strings.Join(ids, ", "))
+	}
+}
+
 // IssueCustomFieldValue holds a resolved custom field value from an issue, including
 // the field metadata and the extracted value.
 type IssueCustomFieldValue struct {
@@ -3,6 +3,7 @@ package rest
 import "errors"
 
 var (
+	ErrBearerTokenCannotBeEmpty         = errors.New("bearer token cannot be empty")
 	ErrClientCannotBeNil                = errors.New("client cannot be nil")
 	ErrJiraClientCannotBeNil            = errors.New("jira client cannot be nil")
 	ErrSimpleClientCannotBeNil          = errors.New("simple client cannot be nil")
@@ -13,5 +14,11 @@ var (
 	ErrIssueOrIssueKeyOrIssueIDRequired = errors.New("issue, issue id, or issue key required")
 	ErrIssuesSetCannotBeNil             = errors.New("issuesSet cannot be nil")
 	ErrFunctionCannotBeNil              = errors.New("function cannot be nil")
-	ErrNotFound                         = errors.New("Issue does not exist or you do not have permission to see it.: request failed. Please analyze the request body for more details. Status code: 400")
+
+	// Sentinels for classifying `*APIError` with `errors.Is`.
+	ErrNotFound         = errors.New("not found")
+	ErrUnauthorized     = errors.New("unauthorized")
+	ErrPermissionDenied = errors.New("permission denied")
+	ErrRateLimited      = errors.New("rate limited")
+	ErrValidation       = errors.New("validation failed")
 )
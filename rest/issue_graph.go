@@ -0,0 +1,386 @@
+package rest
+
+import (
+	"errors"
+	"slices"
+	"sort"
+	"strings"
+	"time"
+
+	jira "github.com/andygrunwald/go-jira"
+	"github.com/grokify/mogo/pointer"
+)
+
+// Jira issue link type names. `LinkTypeParent` is not a Jira link type; it is used for
+// parent/child edges.
+const (
+	LinkTypeBlocks    = "Blocks"
+	LinkTypeCloners   = "Cloners"
+	LinkTypeDuplicate = "Duplicate"
+	LinkTypeRelates   = "Relates"
+	LinkTypeParent    = "Parent"
+
+	linkOutwardParent = "is parent of"
+	linkInwardParent  = "is child of"
+)
+
+var ErrIssueGraphCycle = errors.New("issue graph has a blocking cycle")
+
+// IssueGraphOptions configures `NewIssueGraph`.
+type IssueGraphOptions struct {
+	// BlockingLinkTypes are the link type names where the inward issue blocks the outward issue,
+	// i.e. `A blocks B`. Empty uses `LinkTypeBlocks`.
+	BlockingLinkTypes []string
+	// ChildrenBlockParents treats unresolved children as blockers of their parent.
+	ChildrenBlockParents bool
+}
+
+// IssueNode is an issue in an `IssueGraph`.
+type IssueNode struct {
+	Key               string        `json:"key"`
+	Summary           string        `json:"summary,omitempty"`
+	Type              string        `json:"type,omitempty"`
+	Status            string        `json:"status,omitempty"`
+	StatusCategory    string        `json:"statusCategory,omitempty"` // e.g. `StatusCategoryKeyDone`
+	Project           string        `json:"project,omitempty"`
+	Resolved          bool          `json:"resolved"`
+	RemainingEstimate time.Duration `json:"remainingEstimate,omitempty"`
+	Loaded            bool          `json:"loaded"` // false if only known from another issue's link
+}
+
+// IssueEdge is a directed link between two issues, read as `From Outward To` or `To Inward From`,
+// e.g. `ABC-1 blocks ABC-2` or `ABC-3 is child of ABC-1`.
+type IssueEdge struct {
+	From    string `json:"from"`
+	To      string `json:"to"`
+	Type    string `json:"type"`    // Jira link type name, or `LinkTypeParent`
+	Outward string `json:"outward"` // e.g. `blocks`
+	Inward  string `json:"inward"`  // e.g. `is blocked by`
+}
+
+// BlockedIssue is an unresolved issue with unresolved direct blockers.
+type BlockedIssue struct {
+	Key      string   `json:"key"`
+	Blockers []string `json:"blockers"`
+}
+
+// IssueGraph is a directed graph of issues, with edges for issue links and parent/child
+// relationships.
+type IssueGraph struct {
+	Nodes    map[string]*IssueNode
+	Edges    []IssueEdge
+	opts     IssueGraphOptions
+	edgeKeys map[string]bool
+}
+
+// NewIssueGraph returns a graph of the issues in the set and its parents. Linked issues not in the
+// set are added as nodes with `Loaded` false; see `IssueService.IssuesSetLoadLinked`.
+func NewIssueGraph(set *IssuesSet, opts *IssueGraphOptions) *IssueGraph {
+	g := &IssueGraph{Nodes: map[string]*IssueNode{}, edgeKeys: map[string]bool{}}
+	if opts != nil {
+		g.opts = *opts
+	}
+	if len(g.opts.BlockingLinkTypes) == 0 {
+		g.opts.BlockingLinkTypes = []string{LinkTypeBlocks}
+	}
+	if set == nil {
+		return g
+	}
+	for _, key := range set.Keys() {
+		g.AddIssue(pointer.Pointer(set.Items[key]))
+	}
+	if set.Parents != nil {
+		for _, key := range set.Parents.Keys() {
+			if _, ok := set.Items[key]; !ok {
+				g.AddIssue(pointer.Pointer(set.Parents.Items[key]))
+			}
+		}
+	}
+	return g
+}
+
+// AddIssue adds an issue with its links and parent.
+func (g *IssueGraph) AddIssue(iss *jira.Issue) {
+	if iss == nil || strings.TrimSpace(iss.Key) == "" {
+		return
+	}
+	node := g.addNode(iss)
+	node.Loaded = true
+	if iss.Fields == nil {
+		return
+	}
+	if iss.Fields.Parent != nil {
+		if parKey := strings.TrimSpace(iss.Fields.Parent.Key); parKey != "" {
+			if _, ok := g.Nodes[parKey]; !ok {
+				g.Nodes[parKey] = &IssueNode{Key: parKey, Project: issueKeyProject(parKey)}
+			}
+			g.addEdge(IssueEdge{From: parKey, To: node.Key, Type: LinkTypeParent, Outward: linkOutwardParent, Inward: linkInwardParent})
+		}
+	}
+	for _, link := range iss.Fields.IssueLinks {
+		if link == nil {
+			continue
+		}
+		if link.OutwardIssue != nil {
+			other := g.addNode(link.OutwardIssue)
+			g.addEdge(IssueEdge{From: node.Key, To: other.Key, Type: link.Type.Name, Outward: link.Type.Outward, Inward: link.Type.Inward})
+		}
+		if link.InwardIssue != nil {
+			other := g.addNode(link.InwardIssue)
+			g.addEdge(IssueEdge{From: other.Key, To: node.Key, Type: link.Type.Name, Outward: link.Type.Outward, Inward: link.Type.Inward})
+		}
+	}
+}
+
+// addNode adds or updates a node. Fields of a loaded node are not replaced by a link's partial issue.
+func (g *IssueGraph) addNode(iss *jira.Issue) *IssueNode {
+	key := strings.TrimSpace(iss.Key)
+	node, ok := g.Nodes[key]
+	if !ok {
+		node = &IssueNode{Key: key, Project: issueKeyProject(key)}
+		g.Nodes[key] = node
+	} else if node.Loaded {
+		return node
+	}
+	if f := iss.Fields; f != nil {
+		node.Summary = f.Summary
+		node.Type = f.Type.Name
+		if f.Status != nil {
+			node.Status = f.Status.Name
+			node.StatusCategory = f.Status.StatusCategory.Key
+		}
+		if f.Project.Key != "" {
+			node.Project = f.Project.Key
+		}
+		node.Resolved = f.Resolution != nil || (f.Status != nil && f.Status.StatusCategory.Key == StatusCategoryKeyDone)
+		node.RemainingEstimate = time.Duration(f.TimeEstimate) * time.Second
+	}
+	return node
+}
+
+// issueKeyProject returns the project key of an issue key, e.g. `ABC` for `ABC-1`.
+func issueKeyProject(key string) string {
+	if i := strings.LastIndex(key, "-"); i > 0 {
+		return key[:i]
+	}
+	return ""
+}
+
+func (g *IssueGraph) addEdge(e IssueEdge) {
+	k := e.From + "|" + e.To + "|" + e.Type
+	if e.From == "" || e.To == "" || g.edgeKeys[k] {
+		return
+	}
+	g.edgeKeys[k] = true
+	g.Edges = append(g.Edges, e)
+}
+
+// Keys returns the node keys, sorted.
+func (g *IssueGraph) Keys() []string {
+	var keys []string
+	for k := range g.Nodes {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	return keys
+}
+
+// KeysUnloaded returns the keys of linked issues and parents that are not loaded, sorted.
+func (g *IssueGraph) KeysUnloaded() []string {
+	var keys []string
+	for k, n := range g.Nodes {
+		if !n.Loaded {
+			keys = append(keys, k)
+		}
+	}
+	sort.Strings(keys)
+	return keys
+}
+
+// EdgesForKey returns the edges to or from an issue.
+func (g *IssueGraph) EdgesForKey(key string) []IssueEdge {
+	var out []IssueEdge
+	for _, e := range g.Edges {
+		if e.From == key || e.To == key {
+			out = append(out, e)
+		}
+	}
+	return out
+}
+
+// DirectBlockers returns the issues that directly block an issue, sorted. With unresolvedOnly,
+// resolved blockers are excluded.
+func (g *IssueGraph) DirectBlockers(key string, unresolvedOnly bool) []string {
+	var out []string
+	for _, e := range g.Edges {
+		var blocker string
+		if e.To == key && slices.Contains(g.opts.BlockingLinkTypes, e.Type) {
+			blocker = e.From
+		} else if e.From == key && e.Type == LinkTypeParent && g.opts.ChildrenBlockParents {
+			blocker = e.To
+		} else {
+			continue
+		}
+		if unresolvedOnly && g.Nodes[blocker].Resolved {
+			continue
+		}
+		if !slices.Contains(out, blocker) {
+			out = append(out, blocker)
+		}
+	}
+	sort.Strings(out)
+	return out
+}
+
+// TransitiveBlockers returns every issue that directly or indirectly blocks an issue, sorted. With
+// unresolvedOnly, resolved issues and the issues blocking only them are excluded.
+func (g *IssueGraph) TransitiveBlockers(key string, unresolvedOnly bool) []string {
+	seen := map[string]bool{key: true}
+	queue := []string{key}
+	var out []string
+	for len(queue) > 0 {
+		k := queue[0]
+		queue = queue[1:]
+		for _, b := range g.DirectBlockers(k, unresolvedOnly) {
+			if !seen[b] {
+				seen[b] = true
+				out = append(out, b)
+				queue = append(queue, b)
+			}
+		}
+	}
+	sort.Strings(out)
+	return out
+}
+
+// Blocked returns the unresolved, loaded issues that have unresolved direct blockers, sorted by key.
+func (g *IssueGraph) Blocked() []BlockedIssue {
+	var out []BlockedIssue
+	for _, k := range g.Keys() {
+		n := g.Nodes[k]
+		if n.Resolved || !n.Loaded {
+			continue
+		}
+		if blockers := g.DirectBlockers(k, true); len(blockers) > 0 {
+			out = append(out, BlockedIssue{Key: k, Blockers: blockers})
+		}
+	}
+	return out
+}
+
+// Cycles returns the groups of issues that block each other in a cycle, including an issue that
+// blocks itself. Each group is sorted, and groups are sorted by their first key.
+func (g *IssueGraph) Cycles() [][]string {
+	// Tarjan's strongly connected components over the "is blocked by" relation.
+	index := map[string]int{}
+	low := map[string]int{}
+	onStack := map[string]bool{}
+	var stack []string
+	var out [][]string
+	next := 0
+	var visit func(k string)
+	visit = func(k string) {
+		index[k], low[k] = next, next
+		next++
+		stack = append(stack, k)
+		onStack[k] = true
+		selfLoop := false
+		for _, b := range g.DirectBlockers(k, false) {
+			if b == k {
+				selfLoop = true
+			}
+			if _, ok := index[b]; !ok {
+				visit(b)
+				low[k] = min(low[k], low[b])
+			} else if onStack[b] {
+				low[k] = min(low[k], index[b])
+			}
+		}
+		if low[k] != index[k] {
+			return
+		}
+		var scc []string
+		for {
+			top := stack[len(stack)-1]
+			stack = stack[:len(stack)-1]
+			onStack[top] = false
+			scc = append(scc, top)
+			if top == k {
+				break
+			}
+		}
+		if len(scc) > 1 || selfLoop {
+			sort.Strings(scc)
+			out = append(out, scc)
+		}
+	}
+	for _, k := range g.Keys() {
+		if _, ok := index[k]; !ok {
+			visit(k)
+		}
+	}
+	sort.Slice(out, func(i, j int) bool { return out[i][0] < out[j][0] })
+	return out
+}
+
+// CriticalPath returns the chain of unresolved blockers with the most remaining estimate that
+// ends at key, first blocker first and key last, with the total remaining estimate. If key is
+// empty, the chain with the most remaining estimate in the graph is returned. Resolved issues are
+// not on the path. `ErrIssueGraphCycle` is returned if the blockers of key form a cycle.
+func (g *IssueGraph) CriticalPath(key string) ([]string, time.Duration, error) {
+	type result struct {
+		path  []string
+		total time.Duration
+	}
+	memo := map[string]result{}
+	visiting := map[string]bool{}
+	var walk func(k string) (result, error)
+	walk = func(k string) (result, error) {
+		if r, ok := memo[k]; ok {
+			return r, nil
+		} else if visiting[k] {
+			return result{}, ErrIssueGraphCycle
+		}
+		visiting[k] = true
+		best := result{}
+		for _, b := range g.DirectBlockers(k, true) {
+			r, err := walk(b)
+			if err != nil {
+				return result{}, err
+			}
+			if r.total > best.total || best.path == nil {
+				best = r
+			}
+		}
+		visiting[k] = false
+		r := result{
+			path:  append(slices.Clone(best.path), k),
+			total: best.total + g.Nodes[k].RemainingEstimate}
+		memo[k] = r
+		return r, nil
+	}
+
+	if key != "" {
+		n, ok := g.Nodes[key]
+		if !ok {
+			return nil, 0, ErrKeyNotFound
+		} else if n.Resolved {
+			return []string{}, 0, nil
+		}
+		r, err := walk(key)
+		return r.path, r.total, err
+	}
+	best := result{path: []string{}}
+	for _, k := range g.Keys() {
+		if g.Nodes[k].Resolved {
+			continue
+		}
+		r, err := walk(k)
+		if err != nil {
+			return nil, 0, err
+		} else if r.total > best.total || (len(best.path) == 0) {
+			best = r
+		}
+	}
+	return best.path, best.total, nil
+}
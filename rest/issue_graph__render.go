@@ -0,0 +1,296 @@
+package rest
+
+import (
+	"fmt"
+	"regexp"
+	"slices"
+	"strings"
+
+	"github.com/grokify/gojira"
+	"github.com/grokify/gojira/web"
+)
+
+const (
+	GraphFormatDOT     = "dot"
+	GraphFormatMermaid = "mermaid"
+
+	GraphClusterProject = "project"
+	GraphClusterEpic    = "epic"
+
+	GraphDirectionTopBottom = "TB"
+	GraphDirectionLeftRight = "LR"
+
+	graphSummaryMaxLength = 60
+)
+
+// graphColors are the fill and border colors for each status category, as in the Jira UI.
+var graphColors = map[string][2]string{
+	StatusCategoryKeyNew:           {"#DFE1E6", "#42526E"},
+	StatusCategoryKeyIndeterminate: {"#DEEBFF", "#0052CC"},
+	StatusCategoryKeyDone:          {"#E3FCEF", "#006644"},
+	"":                             {"#FFFFFF", "#6B778C"},
+}
+
+// IssueGraphRenderOptions configures `IssueGraph.DOT` and `IssueGraph.Mermaid`.
+type IssueGraphRenderOptions struct {
+	ServerURL    string   // links nodes to issues with `web.IssueURLWebFromIssueKey`; empty omits links
+	LabelSummary bool     // add the summary to node labels
+	LabelType    bool     // add the issue type to node labels
+	LabelStatus  bool     // add the status to node labels
+	Cluster      string   // `GraphClusterProject`, `GraphClusterEpic` or empty for none
+	Direction    string   // `GraphDirectionTopBottom` (default) or `GraphDirectionLeftRight`
+	LinkTypes    []string // link type names to draw; empty draws all. Parent edges are always drawn.
+}
+
+// Render returns the graph as DOT or Mermaid text, by `GraphFormatDOT` or `GraphFormatMermaid`.
+func (g *IssueGraph) Render(format string, opts *IssueGraphRenderOptions) (string, error) {
+	switch strings.ToLower(strings.TrimSpace(format)) {
+	case GraphFormatDOT:
+		return g.DOT(opts), nil
+	case GraphFormatMermaid:
+		return g.Mermaid(opts), nil
+	default:
+		return "", fmt.Errorf("graph format not supported (%s)", format)
+	}
+}
+
+// DOT returns the graph as Graphviz DOT text. Nodes are filled by status category, and nodes for
+// issues that are not loaded have dashed borders. Issue links are dashed and labeled.
+func (g *IssueGraph) DOT(opts *IssueGraphRenderOptions) string {
+	opts = graphRenderOptionsOrDefault(opts)
+	var sb strings.Builder
+	sb.WriteString("digraph issues {\n")
+	fmt.Fprintf(&sb, "  rankdir=%s;\n", opts.Direction)
+	sb.WriteString("  node [shape=box, style=\"rounded,filled\", fontname=\"Helvetica\", fontsize=10];\n")
+	sb.WriteString("  edge [fontname=\"Helvetica\", fontsize=9];\n")
+
+	writeNode := func(indent string, n *IssueNode) {
+		colors := graphColors[graphColorKey(n.StatusCategory)]
+		attrs := []string{
+			"label=" + dotQuote(strings.Join(g.nodeLabelLines(n, opts), "\n")),
+			"fillcolor=" + dotQuote(colors[0]),
+			"color=" + dotQuote(colors[1])}
+		if !n.Loaded {
+			attrs = append(attrs, `style="rounded,filled,dashed"`)
+		}
+		if u := graphNodeURL(opts, n.Key); u != "" {
+			attrs = append(attrs, "URL="+dotQuote(u), `target="_blank"`)
+		}
+		fmt.Fprintf(&sb, "%s%s [%s];\n", indent, dotQuote(n.Key), strings.Join(attrs, ", "))
+	}
+	clusters, names, unclustered := g.clusters(opts.Cluster)
+	for i, name := range names {
+		fmt.Fprintf(&sb, "  subgraph %s {\n", dotQuote(fmt.Sprintf("cluster_%d", i)))
+		fmt.Fprintf(&sb, "    label=%s;\n    style=rounded;\n    color=\"#C1C7D0\";\n", dotQuote(g.clusterLabel(opts.Cluster, name)))
+		for _, key := range clusters[name] {
+			writeNode("    ", g.Nodes[key])
+		}
+		sb.WriteString("  }\n")
+	}
+	for _, key := range unclustered {
+		writeNode("  ", g.Nodes[key])
+	}
+	for _, e := range g.renderEdges(opts) {
+		if e.Type == LinkTypeParent {
+			fmt.Fprintf(&sb, "  %s -> %s;\n", dotQuote(e.From), dotQuote(e.To))
+		} else {
+			fmt.Fprintf(&sb, "  %s -> %s [label=%s, style=dashed];\n", dotQuote(e.From), dotQuote(e.To), dotQuote(e.Outward))
+		}
+	}
+	sb.WriteString("}\n")
+	return sb.String()
+}
+
+// Mermaid returns the graph as Mermaid flowchart text. Nodes are styled by status category, and
+// nodes for issues that are not loaded have dashed borders. Issue links are dotted and labeled.
+func (g *IssueGraph) Mermaid(opts *IssueGraphRenderOptions) string {
+	opts = graphRenderOptionsOrDefault(opts)
+	var sb strings.Builder
+	fmt.Fprintf(&sb, "flowchart %s\n", opts.Direction)
+
+	writeNode := func(indent string, n *IssueNode) {
+		fmt.Fprintf(&sb, "%s%s[\"%s\"]\n", indent, mermaidID(n.Key), mermaidEscape(strings.Join(g.nodeLabelLines(n, opts), "<br/>")))
+	}
+	clusters, names, unclustered := g.clusters(opts.Cluster)
+	for i, name := range names {
+		fmt.Fprintf(&sb, "  subgraph cluster_%d[\"%s\"]\n", i, mermaidEscape(g.clusterLabel(opts.Cluster, name)))
+		for _, key := range clusters[name] {
+			writeNode("    ", g.Nodes[key])
+		}
+		sb.WriteString("  end\n")
+	}
+	for _, key := range unclustered {
+		writeNode("  ", g.Nodes[key])
+	}
+	for _, e := range g.renderEdges(opts) {
+		if e.Type == LinkTypeParent {
+			fmt.Fprintf(&sb, "  %s --> %s\n", mermaidID(e.From), mermaidID(e.To))
+		} else {
+			fmt.Fprintf(&sb, "  %s -. \"%s\" .-> %s\n", mermaidID(e.From), mermaidEscape(e.Outward), mermaidID(e.To))
+		}
+	}
+
+	classes := map[string][]string{}
+	for _, key := range g.Keys() {
+		n := g.Nodes[key]
+		class := graphColorKey(n.StatusCategory)
+		if class == "" {
+			class = "unknown"
+		}
+		if !n.Loaded {
+			class += "Unloaded"
+		}
+		classes[class] = append(classes[class], mermaidID(key))
+		if u := graphNodeURL(opts, key); u != "" {
+			fmt.Fprintf(&sb, "  click %s href \"%s\" _blank\n", mermaidID(key), u)
+		}
+	}
+	for _, cat := range []string{StatusCategoryKeyNew, StatusCategoryKeyIndeterminate, StatusCategoryKeyDone, ""} {
+		colors := graphColors[cat]
+		class := cat
+		if class == "" {
+			class = "unknown"
+		}
+		for _, c := range []string{class, class + "Unloaded"} {
+			if len(classes[c]) == 0 {
+				continue
+			}
+			style := fmt.Sprintf("fill:%s,stroke:%s", colors[0], colors[1])
+			if c != class {
+				style += ",stroke-dasharray:4 3"
+			}
+			fmt.Fprintf(&sb, "  classDef %s %s\n", c, style)
+			fmt.Fprintf(&sb, "  class %s %s\n", strings.Join(classes[c], ","), c)
+		}
+	}
+	return sb.String()
+}
+
+func graphRenderOptionsOrDefault(opts *IssueGraphRenderOptions) *IssueGraphRenderOptions {
+	out := IssueGraphRenderOptions{}
+	if opts != nil {
+		out = *opts
+	}
+	if dir := strings.ToUpper(strings.TrimSpace(out.Direction)); dir == GraphDirectionLeftRight {
+		out.Direction = GraphDirectionLeftRight
+	} else {
+		out.Direction = GraphDirectionTopBottom
+	}
+	out.Cluster = strings.ToLower(strings.TrimSpace(out.Cluster))
+	return &out
+}
+
+// graphColorKey returns the status category key if it has colors, or else empty.
+func graphColorKey(statusCategory string) string {
+	if _, ok := graphColors[statusCategory]; ok {
+		return statusCategory
+	}
+	return ""
+}
+
+func graphNodeURL(opts *IssueGraphRenderOptions, key string) string {
+	if strings.TrimSpace(opts.ServerURL) == "" {
+		return ""
+	}
+	return web.IssueURLWebOrEmptyFromIssueKey(opts.ServerURL, key)
+}
+
+func (g *IssueGraph) nodeLabelLines(n *IssueNode, opts *IssueGraphRenderOptions) []string {
+	first := n.Key
+	if opts.LabelType && n.Type != "" {
+		first += " (" + n.Type + ")"
+	}
+	lines := []string{first}
+	if opts.LabelSummary && n.Summary != "" {
+		lines = append(lines, truncateRunes(n.Summary, graphSummaryMaxLength))
+	}
+	if opts.LabelStatus && n.Status != "" {
+		lines = append(lines, "["+n.Status+"]")
+	}
+	return lines
+}
+
+// renderEdges returns the parent edges and the issue links of the types in `opts.LinkTypes`.
+func (g *IssueGraph) renderEdges(opts *IssueGraphRenderOptions) []IssueEdge {
+	var out []IssueEdge
+	for _, e := range g.Edges {
+		if e.Type == LinkTypeParent || len(opts.LinkTypes) == 0 || slices.Contains(opts.LinkTypes, e.Type) {
+			out = append(out, e)
+		}
+	}
+	return out
+}
+
+// clusters groups node keys by project, or by the nearest epic, which is in its own cluster. It
+// returns the keys of each cluster, the sorted cluster names and the keys not in a cluster.
+func (g *IssueGraph) clusters(cluster string) (map[string][]string, []string, []string) {
+	out := map[string][]string{}
+	var unclustered []string
+	parents := map[string]string{}
+	for _, e := range g.Edges {
+		if e.Type == LinkTypeParent {
+			parents[e.To] = e.From
+		}
+	}
+	for _, key := range g.Keys() {
+		name := ""
+		switch cluster {
+		case GraphClusterProject:
+			name = g.Nodes[key].Project
+		case GraphClusterEpic:
+			seen := map[string]bool{}
+			for k := key; k != "" && !seen[k]; k = parents[k] {
+				seen[k] = true
+				if n, ok := g.Nodes[k]; ok && strings.EqualFold(n.Type, gojira.TypeEpic) {
+					name = k
+					break
+				}
+			}
+		}
+		if name == "" {
+			unclustered = append(unclustered, key)
+		} else {
+			out[name] = append(out[name], key)
+		}
+	}
+	var names []string
+	for name := range out {
+		names = append(names, name)
+	}
+	slices.Sort(names)
+	return out, names, unclustered
+}
+
+func (g *IssueGraph) clusterLabel(cluster, name string) string {
+	if cluster == GraphClusterEpic {
+		if n, ok := g.Nodes[name]; ok && n.Summary != "" {
+			return name + ": " + truncateRunes(n.Summary, graphSummaryMaxLength)
+		}
+	}
+	return name
+}
+
+func truncateRunes(s string, maxLen int) string {
+	if r := []rune(s); len(r) > maxLen {
+		return string(r[:maxLen-3]) + "..."
+	}
+	return s
+}
+
+// dotQuote returns a DOT quoted string. Newlines are DOT line breaks.
+func dotQuote(s string) string {
+	s = strings.NewReplacer(`\`, `\\`, `"`, `\"`, "\n", `\n`).Replace(s)
+	return `"` + s + `"`
+}
+
+var rxMermaidID = regexp.MustCompile(`[^A-Za-z0-9_]`)
+
+// mermaidID returns a Mermaid node ID for an issue key, e.g. `ABC_1` for `ABC-1`.
+func mermaidID(key string) string {
+	return rxMermaidID.ReplaceAllString(key, "_")
+}
+
+// mermaidEscape escapes text in a quoted Mermaid label.
+func mermaidEscape(s string) string {
+	return strings.NewReplacer(`"`, "#quot;", "<br/>", "<br/>", "<", "#lt;", ">", "#gt;").Replace(s)
+}
@@ -0,0 +1,132 @@
+package rest
+
+import (
+	"errors"
+	"reflect"
+	"strings"
+	"testing"
+	"time"
+
+	jira "github.com/andygrunwald/go-jira"
+)
+
+func issueGraphTestIssue(key string, estimateHours int, outward, inward []string) jira.Issue {
+	blocks := jira.IssueLinkType{Name: LinkTypeBlocks, Inward: "is blocked by", Outward: "blocks"}
+	iss := jira.Issue{Key: key, Fields: &jira.IssueFields{
+		Summary:      "Issue " + key,
+		Status:       &jira.Status{Name: "To Do"},
+		TimeEstimate: estimateHours * 3600}}
+	for _, k := range outward {
+		iss.Fields.IssueLinks = append(iss.Fields.IssueLinks, &jira.IssueLink{Type: blocks, OutwardIssue: &jira.Issue{Key: k}})
+	}
+	for _, k := range inward {
+		iss.Fields.IssueLinks = append(iss.Fields.IssueLinks, &jira.IssueLink{Type: blocks, InwardIssue: &jira.Issue{Key: k,
+			Fields: &jira.IssueFields{Status: &jira.Status{Name: "Done", StatusCategory: jira.StatusCategory{Key: StatusCategoryKeyDone}}}}})
+	}
+	return iss
+}
+
+func TestIssueGraph(t *testing.T) {
+	set := NewIssuesSet(nil)
+	parent := issueGraphTestIssue("P", 0, nil, nil)
+	a := issueGraphTestIssue("A", 2, []string{"B"}, nil)
+	a.Fields.Parent = &jira.Parent{Key: "P"}
+	err := set.Add(parent, a,
+		issueGraphTestIssue("B", 1, []string{"C"}, nil),
+		issueGraphTestIssue("C", 1, nil, []string{"E"}),
+		issueGraphTestIssue("D", 5, []string{"C"}, nil),
+		issueGraphTestIssue("X", 1, []string{"Y"}, nil),
+		issueGraphTestIssue("Y", 1, []string{"X"}, nil))
+	if err != nil {
+		t.Fatalf("IssuesSet.Add() error = %v", err)
+	}
+	g := NewIssueGraph(set, nil)
+
+	if got := strings.Join(g.KeysUnloaded(), ","); got != "E" {
+		t.Errorf("IssueGraph.KeysUnloaded() mismatch: want (%s), got (%s)", "E", got)
+	}
+	if got := strings.Join(g.DirectBlockers("C", false), ","); got != "B,D,E" {
+		t.Errorf("IssueGraph.DirectBlockers() mismatch: want (%s), got (%s)", "B,D,E", got)
+	}
+	if got := strings.Join(g.TransitiveBlockers("C", true), ","); got != "A,B,D" {
+		t.Errorf("IssueGraph.TransitiveBlockers() mismatch: want (%s), got (%s)", "A,B,D", got)
+	}
+	wantBlocked := []BlockedIssue{{"B", []string{"A"}}, {"C", []string{"B", "D"}}, {"X", []string{"Y"}}, {"Y", []string{"X"}}}
+	if got := g.Blocked(); !reflect.DeepEqual(got, wantBlocked) {
+		t.Errorf("IssueGraph.Blocked() mismatch: want (%v), got (%v)", wantBlocked, got)
+	}
+	if got := g.Cycles(); !reflect.DeepEqual(got, [][]string{{"X", "Y"}}) {
+		t.Errorf("IssueGraph.Cycles() mismatch: want (%v), got (%v)", [][]string{{"X", "Y"}}, got)
+	}
+	path, total, err := g.CriticalPath("C")
+	if err != nil {
+		t.Fatalf("IssueGraph.CriticalPath() error = %v", err)
+	} else if strings.Join(path, ",") != "D,C" || total != 6*time.Hour {
+		t.Errorf("IssueGraph.CriticalPath() mismatch: want (D,C 6h), got (%v %v)", path, total)
+	}
+	if _, _, err := g.CriticalPath("X"); !errors.Is(err, ErrIssueGraphCycle) {
+		t.Errorf("IssueGraph.CriticalPath() mismatch: want (%v), got (%v)", ErrIssueGraphCycle, err)
+	}
+
+	// children block their parent
+	g = NewIssueGraph(set, &IssueGraphOptions{ChildrenBlockParents: true})
+	if got := strings.Join(g.DirectBlockers("P", true), ","); got != "A" {
+		t.Errorf("IssueGraph.DirectBlockers() children mismatch: want (%s), got (%s)", "A", got)
+	}
+}
+
+func TestIssueGraphRender(t *testing.T) {
+	epic := jira.Issue{Key: "ABC-1", Fields: &jira.IssueFields{
+		Summary: `Launch "v2"`,
+		Type:    jira.IssueType{Name: "Epic"},
+		Status:  &jira.Status{Name: "In Progress", StatusCategory: jira.StatusCategory{Key: StatusCategoryKeyIndeterminate}}}}
+	story := issueGraphTestIssue("ABC-2", 1, []string{"XYZ-9"}, nil)
+	story.Fields.Parent = &jira.Parent{Key: "ABC-1"}
+	set := NewIssuesSet(nil)
+	if err := set.Add(epic, story); err != nil {
+		t.Fatalf("IssuesSet.Add() error = %v", err)
+	}
+	g := NewIssueGraph(set, nil)
+	opts := &IssueGraphRenderOptions{
+		ServerURL:    "https://example.atlassian.net",
+		LabelSummary: true,
+		LabelType:    true,
+		Cluster:      GraphClusterEpic}
+
+	tests := []struct {
+		format string
+		want   []string
+	}{
+		{GraphFormatDOT, []string{
+			"digraph issues {",
+			`subgraph "cluster_0" {`,
+			`label="ABC-1: Launch \"v2\"";`,
+			`"ABC-1" [label="ABC-1 (Epic)\nLaunch \"v2\"", fillcolor="#DEEBFF", color="#0052CC", URL="https://example.atlassian.net/browse/ABC-1", target="_blank"];`,
+			`"XYZ-9" [label="XYZ-9", fillcolor="#FFFFFF", color="#6B778C", style="rounded,filled,dashed", URL=`,
+			`"ABC-1" -> "ABC-2";`,
+			`"ABC-2" -> "XYZ-9" [label="blocks", style=dashed];`}},
+		{GraphFormatMermaid, []string{
+			"flowchart TB",
+			`subgraph cluster_0["ABC-1: Launch #quot;v2#quot;"]`,
+			`ABC_1["ABC-1 (Epic)<br/>Launch #quot;v2#quot;"]`,
+			"ABC_1 --> ABC_2",
+			`ABC_2 -. "blocks" .-> XYZ_9`,
+			`click ABC_1 href "https://example.atlassian.net/browse/ABC-1" _blank`,
+			"class ABC_1 indeterminate",
+			"classDef unknownUnloaded fill:#FFFFFF,stroke:#6B778C,stroke-dasharray:4 3"}},
+	}
+	for _, tt := range tests {
+		got, err := g.Render(tt.format, opts)
+		if err != nil {
+			t.Fatalf("IssueGraph.Render(%s) error = %v", tt.format, err)
+		}
+		for _, want := range tt.want {
+			if !strings.Contains(got, want) {
+				t.Errorf("IssueGraph.Render(%s) mismatch: want (%s), got (%s)", tt.format, want, got)
+			}
+		}
+	}
+	if _, err := g.Render("svg", nil); err == nil {
+		t.Errorf("IssueGraph.Render(svg) mismatch: want error, got nil")
+	}
+}
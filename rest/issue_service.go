@@ -3,7 +3,7 @@ package rest
 import (
 	"context"
 	"errors"
-	"fmt"
+	"slices"
 	"strings"
 
 	jira "github.com/andygrunwald/go-jira"
@@ -22,21 +22,33 @@ func NewIssueService(client *Client) *IssueService {
 }
 
 type GetQueryOptions struct {
-	ExpandChangelog    bool // sent to andygrunwald SDK
-	XMultiSkipNotFound bool // not sent to andygrunwald SDK; used for getting multiple issues
+	ExpandChangelog    bool     // sent to andygrunwald SDK
+	Expand             []string // sent to andygrunwald SDK; combined with `ExpandChangelog`
+	Fields             []string // sent to andygrunwald SDK; empty means all fields
+	XMultiSkipNotFound bool     // not sent to andygrunwald SDK; used for getting multiple issues
 	XIncludeParents    bool
 	// XMultiRecursive    bool
 }
 
 // Build returns a `*jira.GetQueryOptions` for the andygrunwald SDK.
 func (opts GetQueryOptions) Build() *jira.GetQueryOptions {
-	out := &jira.GetQueryOptions{}
-	if opts.ExpandChangelog {
-		out.Expand = "changelog"
+	out := &jira.GetQueryOptions{
+		Expand: opts.SearchOptions().ExpandOrDefault("")}
+	if fields := stringsutil.SliceCondenseSpace(opts.Fields, true, false); len(fields) > 0 {
+		out.Fields = strings.Join(fields, ",")
 	}
 	return out
 }
 
+// SearchOptions returns the `SearchOptions` for fetching multiple issues with these options.
+func (opts GetQueryOptions) SearchOptions() SearchOptions {
+	expand := slices.Clone(opts.Expand)
+	if opts.ExpandChangelog {
+		expand = append(expand, ExpandFieldChangelog)
+	}
+	return SearchOptions{Fields: opts.Fields, Expand: expand}
+}
+
 func (svc *IssueService) Issue(ctx context.Context, issueIDOrKey string, opts *GetQueryOptions) (*jira.Issue, error) {
 	issueIDOrKey = strings.TrimSpace(issueIDOrKey)
 
@@ -53,18 +65,17 @@ func (svc *IssueService) Issue(ctx context.Context, issueIDOrKey string, opts *G
 	} else if svc.Client.JiraClient.Issue == nil {
 		return nil, errors.New("gojira.Client.JiraClient.issue cannot be nil")
 	} else if iss, resp, err := svc.Client.JiraClient.Issue.GetWithContext(ctx, issueIDOrKey, opts2); err != nil {
-		return nil, err
+		return nil, JiraResponseError(resp, err)
 	} else if resp.StatusCode >= 300 {
-		return nil, fmt.Errorf("unsuccessful jira api http status code (%d)", resp.StatusCode)
+		return nil, JiraResponseError(resp, nil)
 	} else {
 		return iss, nil
 	}
 }
 
 // Issues returns a list of issues given a set of keys. If no keys are provided,
-// an empty slice is returned. The opts parameter is reserved for future use and
-// currently has no effect on the search query.
-func (svc *IssueService) Issues(ctx context.Context, keys []string, _ *GetQueryOptions) (Issues, error) {
+// an empty slice is returned. If opts is set, its fields and expansions are requested.
+func (svc *IssueService) Issues(ctx context.Context, keys []string, opts *GetQueryOptions) (Issues, error) {
 	keys = stringsutil.SliceCondenseSpace(keys, true, true)
 	if len(keys) == 0 {
 		return Issues{}, nil
@@ -72,7 +83,11 @@ func (svc *IssueService) Issues(ctx context.Context, keys []string, _ *GetQueryO
 	j := gojira.JQL{
 		IssuesIncl: [][]string{keys},
 	}
-	return svc.SearchIssuesAPIV3(ctx, j.String(), true)
+	var sopts []SearchOptions
+	if opts != nil {
+		sopts = append(sopts, opts.SearchOptions())
+	}
+	return svc.SearchIssuesAPIV3(ctx, j.String(), true, sopts...)
 }
 
 // Issues returns an `IssuesSet{}` given a set of keys. If no keys are provided,
@@ -0,0 +1,116 @@
+package rest
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strings"
+
+	jira "github.com/andygrunwald/go-jira"
+)
+
+const (
+	// ChangelogMaxResultsEmbedded is the maximum number of histories Jira returns in a changelog
+	// embedded with `expand=changelog`. An embedded changelog of this length may be truncated.
+	ChangelogMaxResultsEmbedded = 100
+
+	changelogPageSize = 100
+)
+
+// changelogPage is a page from the `/rest/api/2/issue/{issueIdOrKey}/changelog` endpoint.
+type changelogPage struct {
+	StartAt    int                     `json:"startAt"`
+	MaxResults int                     `json:"maxResults"`
+	Total      int                     `json:"total"`
+	IsLast     bool                    `json:"isLast"`
+	Values     []jira.ChangelogHistory `json:"values"`
+}
+
+// Changelog returns the complete changelog for an issue, paging the `/rest/api/2/issue/{issueIdOrKey}/changelog`
+// endpoint. If the endpoint is not available, as on some Jira Data Center versions, the changelog is
+// read from the issue using `expand=changelog`.
+func (svc *IssueService) Changelog(ctx context.Context, issueIDOrKey string) (*jira.Changelog, error) {
+	if issueIDOrKey = strings.TrimSpace(issueIDOrKey); issueIDOrKey == "" {
+		return nil, ErrIssueKeyCannotBeEmpty
+	} else if svc.Client == nil || svc.Client.JiraClient == nil {
+		return nil, ErrJiraClientCannotBeNil
+	}
+	out := &jira.Changelog{Histories: []jira.ChangelogHistory{}}
+	for startAt := 0; ; {
+		apiEndpoint := fmt.Sprintf("rest/api/2/issue/%s/changelog?%s", url.PathEscape(issueIDOrKey),
+			url.Values{"startAt": {fmt.Sprintf("%d", startAt)}, "maxResults": {fmt.Sprintf("%d", changelogPageSize)}}.Encode())
+		req, err := svc.Client.JiraClient.NewRequestWithContext(ctx, http.MethodGet, apiEndpoint, nil)
+		if err != nil {
+			return nil, err
+		}
+		var page changelogPage
+		if resp, err := svc.Client.JiraClient.Do(req, &page); err != nil {
+			err = JiraResponseError(resp, err)
+			if startAt == 0 && IsNotFound(err) {
+				return svc.changelogFromIssue(ctx, issueIDOrKey)
+			}
+			return nil, err
+		}
+		out.Histories = append(out.Histories, page.Values...)
+		startAt += len(page.Values)
+		if page.IsLast || len(page.Values) == 0 || (page.Total > 0 && startAt >= page.Total) {
+			return out, nil
+		}
+	}
+}
+
+func (svc *IssueService) changelogFromIssue(ctx context.Context, issueIDOrKey string) (*jira.Changelog, error) {
+	iss, err := svc.Issue(ctx, issueIDOrKey, &GetQueryOptions{
+		ExpandChangelog: true,
+		Fields:          []string{"status"}})
+	if err != nil {
+		return nil, err
+	} else if iss.Changelog == nil {
+		return &jira.Changelog{Histories: []jira.ChangelogHistory{}}, nil
+	}
+	return iss.Changelog, nil
+}
+
+// ChangelogIsComplete returns false if an issue has no changelog or its embedded changelog may have
+// been truncated, in which case `IssueService.Changelog` should be used to retrieve it.
+func ChangelogIsComplete(iss *jira.Issue) bool {
+	return iss != nil && iss.Changelog != nil && len(iss.Changelog.Histories) < ChangelogMaxResultsEmbedded
+}
+
+// StatusHistory returns an issue's status history, retrieving the complete changelog first if
+// the issue has no changelog or its embedded changelog may be truncated. iss is not modified.
+func (svc *IssueService) StatusHistory(ctx context.Context, iss *jira.Issue) (StatusHistory, error) {
+	if iss == nil {
+		return StatusHistory{}, ErrIssueCannotBeNil
+	} else if ChangelogIsComplete(iss) {
+		return NewStatusHistory(iss)
+	}
+	cl, err := svc.Changelog(ctx, iss.Key)
+	if err != nil {
+		return StatusHistory{}, err
+	}
+	issCopy := *iss
+	issCopy.Changelog = cl
+	return NewStatusHistory(&issCopy)
+}
+
+// IssuesSetLoadChangelogs retrieves the complete changelog for each issue in the set that has no
+// changelog or a possibly truncated one, so `IssuesSet.StatusHistories` covers every issue.
+func (svc *IssueService) IssuesSetLoadChangelogs(ctx context.Context, set *IssuesSet) error {
+	if set == nil {
+		return ErrIssuesSetCannotBeNil
+	}
+	for key, iss := range set.Items {
+		if ChangelogIsComplete(&iss) {
+			continue
+		}
+		cl, err := svc.Changelog(ctx, key)
+		if err != nil {
+			return err
+		}
+		iss.Changelog = cl
+		set.Items[key] = iss
+	}
+	return nil
+}
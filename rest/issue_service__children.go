@@ -0,0 +1,47 @@
+package rest
+
+import (
+	"context"
+	"errors"
+
+	"github.com/grokify/mogo/type/slicesutil"
+
+	"github.com/grokify/gojira"
+)
+
+// IssuesSetLoadChildren adds the descendants of the issues in the set, up to `depth` levels. Options
+// set the fields requested, as for `SearchIssues`.
+func (svc *IssueService) IssuesSetLoadChildren(ctx context.Context, set *IssuesSet, depth int, opts ...SearchOptions) error {
+	if set == nil {
+		return errors.New("issues set is nil")
+	}
+	keys := set.Keys()
+	seen := map[string]bool{}
+	for _, key := range keys {
+		seen[key] = true
+	}
+	for range depth {
+		var children []string
+		for _, chunk := range slicesutil.SplitMaxLength(keys, gojira.JQLMaxResults) {
+			jql := gojira.JQL{ParentsIncl: [][]string{chunk}}
+			ii, err := CollectIssues(svc.SearchIssuesSeq(ctx, jql.String(), opts...))
+			if err != nil {
+				return err
+			}
+			for _, iss := range ii {
+				if !seen[iss.Key] {
+					seen[iss.Key] = true
+					children = append(children, iss.Key)
+				}
+			}
+			if err := set.Add(ii...); err != nil {
+				return err
+			}
+		}
+		if len(children) == 0 {
+			return nil
+		}
+		keys = children
+	}
+	return nil
+}
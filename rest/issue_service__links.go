@@ -0,0 +1,67 @@
+package rest
+
+import (
+	"context"
+	"errors"
+
+	"github.com/grokify/mogo/type/slicesutil"
+
+	"github.com/grokify/gojira"
+)
+
+// IssuesSetLoadLinked adds the linked issues and parents that are not in the set, following links
+// up to `depth` levels from the issues in the set. Options set the fields requested, as for
+// `SearchIssues`; requested fields should include `issuelinks` and `parent` to follow links further.
+// Keys that cannot be loaded, such as deleted issues or issues the user cannot view, are skipped
+// and remain in `IssueGraph.KeysUnloaded`.
+func (svc *IssueService) IssuesSetLoadLinked(ctx context.Context, set *IssuesSet, depth int, opts ...SearchOptions) error {
+	if set == nil {
+		return errors.New("issues set is nil")
+	}
+	tried := map[string]bool{}
+	for range depth {
+		var keys []string
+		for _, key := range NewIssueGraph(set, nil).KeysUnloaded() {
+			if !tried[key] {
+				tried[key] = true
+				keys = append(keys, key)
+			}
+		}
+		if len(keys) == 0 {
+			return nil
+		}
+		for _, chunk := range slicesutil.SplitMaxLength(keys, gojira.JQLMaxResults) {
+			ii, err := svc.searchKeys(ctx, chunk, opts...)
+			if errorIsUnknownKey(err) {
+				// One unknown key fails the whole query, so search each key on its own.
+				ii = nil
+				for _, key := range chunk {
+					one, err := svc.searchKeys(ctx, []string{key}, opts...)
+					if errorIsUnknownKey(err) {
+						continue
+					} else if err != nil {
+						return err
+					}
+					ii = append(ii, one...)
+				}
+			} else if err != nil {
+				return err
+			}
+			if err := set.Add(ii...); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+func (svc *IssueService) searchKeys(ctx context.Context, keys []string, opts ...SearchOptions) (Issues, error) {
+	jql := gojira.JQL{KeysIncl: [][]string{keys}}
+	return CollectIssues(svc.SearchIssuesSeq(ctx, jql.String(), opts...))
+}
+
+// errorIsUnknownKey returns true if a key search failed because a key does not exist or cannot
+// be viewed. Jira returns HTTP 400 for both.
+func errorIsUnknownKey(err error) bool {
+	return errors.Is(err, ErrNotFound) || errors.Is(err, ErrValidation)
+}
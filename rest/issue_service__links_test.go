@@ -0,0 +1,53 @@
+package rest
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"regexp"
+	"strings"
+	"testing"
+
+	jira "github.com/andygrunwald/go-jira"
+)
+
+func TestIssuesSetLoadLinkedUnknownKey(t *testing.T) {
+	rxKey := regexp.MustCompile(`[A-Z]+-[0-9]+`)
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		jql := r.URL.Query().Get("jql")
+		if strings.Contains(jql, "ABC-404") {
+			w.WriteHeader(http.StatusBadRequest)
+			_, _ = w.Write([]byte(`{"errorMessages":["An issue with key 'ABC-404' does not exist for field 'key'."]}`))
+			return
+		}
+		var issues []jira.Issue
+		for _, key := range rxKey.FindAllString(jql, -1) {
+			issues = append(issues, issueGraphTestIssue(key, 1, nil, nil))
+		}
+		if err := json.NewEncoder(w).Encode(map[string]any{"isLast": true, "issues": issues}); err != nil {
+			t.Errorf("failed to encode search result: %v", err)
+		}
+	}))
+	defer server.Close()
+	jiraClient, err := jira.NewClient(nil, server.URL)
+	if err != nil {
+		t.Fatalf("failed to create jira client: %v", err)
+	}
+	svc := NewIssueService(&Client{JiraClient: jiraClient})
+
+	set := NewIssuesSet(nil)
+	if err := set.Add(issueGraphTestIssue("ABC-1", 1, []string{"ABC-2", "ABC-404", "ABC-3"}, nil)); err != nil {
+		t.Fatalf("IssuesSet.Add() error = %v", err)
+	}
+	if err := svc.IssuesSetLoadLinked(context.Background(), set, 2); err != nil {
+		t.Fatalf("IssueService.IssuesSetLoadLinked() error = %v", err)
+	}
+	if keys := strings.Join(set.Keys(), ","); keys != "ABC-1,ABC-2,ABC-3" {
+		t.Errorf("IssueService.IssuesSetLoadLinked() keys mismatch: want (%s), got (%s)", "ABC-1,ABC-2,ABC-3", keys)
+	}
+	if unloaded := strings.Join(NewIssueGraph(set, nil).KeysUnloaded(), ","); unloaded != "ABC-404" {
+		t.Errorf("IssueGraph.KeysUnloaded() mismatch: want (%s), got (%s)", "ABC-404", unloaded)
+	}
+}
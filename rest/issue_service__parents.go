@@ -1,6 +1,13 @@
 package rest
 
-import "errors"
+import (
+	"context"
+	"errors"
+
+	"github.com/grokify/mogo/type/slicesutil"
+
+	"github.com/grokify/gojira"
+)
 
 func (svc *IssueService) IssuesSetAddParents(set *IssuesSet) error {
 	if set == nil {
@@ -12,3 +19,37 @@ func (svc *IssueService) IssuesSetAddParents(set *IssuesSet) error {
 		return nil
 	}
 }
+
+// IssuesSetLoadAncestors adds the parents of the issues in the set to `set.Parents`, up to `depth`
+// levels. Options set the fields requested, as for `SearchIssues`; requested fields should include
+// `parent` to load more than one level.
+func (svc *IssueService) IssuesSetLoadAncestors(ctx context.Context, set *IssuesSet, depth int, opts ...SearchOptions) error {
+	if set == nil {
+		return errors.New("issues set is nil")
+	} else if set.Parents == nil {
+		set.Parents = NewIssuesSet(set.Config)
+	}
+	tried := map[string]bool{}
+	for range depth {
+		var keys []string
+		for _, key := range append(set.KeysParentsUnpopulated(), set.Parents.KeysParents()...) {
+			if _, ok := set.IssueOrParent(key); !ok && !tried[key] {
+				tried[key] = true
+				keys = append(keys, key)
+			}
+		}
+		if len(keys) == 0 {
+			return nil
+		}
+		for _, chunk := range slicesutil.SplitMaxLength(keys, gojira.JQLMaxResults) {
+			jql := gojira.JQL{KeysIncl: [][]string{chunk}}
+			ii, err := CollectIssues(svc.SearchIssuesSeq(ctx, jql.String(), opts...))
+			if err != nil {
+				return err
+			} else if err := set.Parents.Add(ii...); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
@@ -0,0 +1,35 @@
+package rest
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"strings"
+)
+
+// rankRequest is the body of the Agile API issue rank endpoint.
+type rankRequest struct {
+	Issues          []string `json:"issues"`
+	RankBeforeIssue string   `json:"rankBeforeIssue,omitempty"`
+	RankAfterIssue  string   `json:"rankAfterIssue,omitempty"`
+}
+
+// RankIssues ranks issues before or after another issue, keeping their order, using the Agile REST API.
+// Exactly one of `beforeKey` and `afterKey` must be set.
+func (svc *IssueService) RankIssues(ctx context.Context, issueKeys []string, beforeKey, afterKey string) error {
+	beforeKey, afterKey = strings.TrimSpace(beforeKey), strings.TrimSpace(afterKey)
+	if (beforeKey == "") == (afterKey == "") {
+		return errors.New("set one of rank before or rank after issue")
+	} else if svc.Client == nil {
+		return ErrJiraClientCannotBeNil
+	}
+	for _, keys := range chunkStrings(issueKeys, AgileIssuesMaxPerRequest) {
+		req := rankRequest{Issues: keys, RankBeforeIssue: beforeKey, RankAfterIssue: afterKey}
+		if err := svc.Client.doJSON(ctx, http.MethodPut, "rest/agile/1.0/issue/rank", req, nil); err != nil {
+			return err
+		}
+		// rank the next chunk after this one to keep the order.
+		beforeKey, afterKey = "", keys[len(keys)-1]
+	}
+	return nil
+}
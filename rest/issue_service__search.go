@@ -2,19 +2,14 @@ package rest
 
 import (
 	"context"
-	"encoding/json"
 	"errors"
 	"fmt"
-	"io"
 	"log/slog"
-	"net/http"
 	"slices"
 	"strings"
 	"time"
 
 	jira "github.com/andygrunwald/go-jira"
-	"github.com/grokify/gojira/rest/apiv3"
-	"github.com/grokify/mogo/net/http/httpsimple"
 	"github.com/grokify/mogo/pointer"
 	"github.com/grokify/mogo/time/month"
 	"github.com/grokify/mogo/type/maputil"
@@ -31,7 +26,7 @@ const (
 )
 
 func (svc *IssueService) SearchIssuesSetWithFileCache(
-	filename string, forceReload, reloadOnError bool, cfg *gojira.Config, prefix, indent, jql string, retrieveAll bool) (*IssuesSet, error) {
+	filename string, forceReload, reloadOnError bool, cfg *gojira.Config, prefix, indent, jql string, retrieveAll bool, opts ...SearchOptions) (*IssuesSet, error) {
 	if !forceReload {
 		if is, err := IssuesSetReadFileJSON(filename); err != nil && !reloadOnError {
 			return nil, err
@@ -39,7 +34,7 @@ func (svc *IssueService) SearchIssuesSetWithFileCache(
 			return is, nil
 		}
 	}
-	if ii, err := svc.SearchIssues(jql, retrieveAll); err != nil {
+	if ii, err := svc.SearchIssues(jql, retrieveAll, opts...); err != nil {
 		return nil, err
 	} else {
 		is := NewIssuesSet(cfg)
@@ -52,41 +47,18 @@ func (svc *IssueService) SearchIssuesSetWithFileCache(
 }
 
 // SearchIssues returns all issues for a JQL query, automatically handling API pagination.
-func (svc *IssueService) SearchIssues(jql string, retrieveAll bool) (Issues, error) {
+// Optional `SearchOptions` set the fields, expansions and page size requested.
+func (svc *IssueService) SearchIssues(jql string, retrieveAll bool, opts ...SearchOptions) (Issues, error) {
 	// New API described here: https://github.com/andygrunwald/go-jira/issues/715
-	var issues Issues
-
-	opts := &jira.SearchOptionsV2{
-		MaxResults: 50,
-		Expand:     ExpandFieldEpic, // CSV
-		Fields:     []string{"*all"},
-	}
-
-	// SearchPages will page through results and pass each issue to appendFunc
-	// In this example, we'll search for all the issues in the target project
-	for {
-		ii, resp, err := svc.Client.JiraClient.Issue.SearchV2JQLWithContext(context.Background(), jql, opts)
-		if err != nil {
-			return issues, err
-		} else if resp.StatusCode > 299 {
-			return issues, fmt.Errorf("api error http status code (%d)", resp.StatusCode)
-		} else {
-			issues = append(issues, ii...)
-		}
-		if resp.IsLast || strings.TrimSpace(resp.NextPageToken) == "" {
-			break
-		} else {
-			opts.NextPageToken = resp.NextPageToken
-		}
-	}
-	return issues, nil
+	return CollectIssues(svc.SearchIssuesSeq(context.Background(), jql, opts...))
 }
 
 // SearchIssuesOnPremise returns all issues for a JQL query using the legacy pagination API.
 // This function is for Jira Server/Data Center (on-premises) deployments only.
 // For Jira Cloud, use SearchIssues or SearchIssuesAPIV3 instead.
-func (svc *IssueService) SearchIssuesOnPremise(jql string, retrieveAll bool) (Issues, error) {
+func (svc *IssueService) SearchIssuesOnPremise(jql string, retrieveAll bool, opts ...SearchOptions) (Issues, error) {
 	var issues Issues
+	so := searchOptionsOrDefault(opts)
 
 	// appendFunc will append jira issues to []jira.Issue
 	appendFunc := func(i jira.Issue) (err error) {
@@ -98,85 +70,25 @@ func (svc *IssueService) SearchIssuesOnPremise(jql string, retrieveAll bool) (Is
 	}
 
 	// SearchPages will page through results and pass each issue to appendFunc
-	err := svc.Client.JiraClient.Issue.SearchPages(jql, &jira.SearchOptions{Expand: "epic"}, appendFunc)
+	err := svc.Client.JiraClient.Issue.SearchPages(jql, &jira.SearchOptions{
+		MaxResults: so.PageSize,
+		Expand:     so.ExpandOrDefault(ExpandFieldEpic),
+		Fields:     so.Fields}, appendFunc)
 	return issues, err
 }
 
 // SearchIssuesAPIV3 returns all issues for a JQL query using the V3 API endpoint /rest/api/3/search/jql.
 // If retrieveAll is true, it will paginate through all results until no more issues are available.
-func (svc *IssueService) SearchIssuesAPIV3(ctx context.Context, jql string, retrieveAll bool) (Issues, error) {
-	if svc.Client == nil {
-		return nil, ErrClientCannotBeNil
-	}
-	if svc.Client.simpleClient == nil {
-		return nil, ErrSimpleClientCannotBeNil
-	}
-	if strings.TrimSpace(jql) == "" {
-		return Issues{}, nil
-	}
-
-	var allIssues Issues
-	maxResults := MaxResults
-
-	nextPageToken := ""
-
-	for {
-		query := map[string][]string{
-			"jql":        {jql},
-			"maxResults": {fmt.Sprintf("%d", maxResults)},
-			"fields":     {"*all"},
-		}
-		if nextPageToken != "" {
-			query["nextPageToken"] = []string{nextPageToken}
-		}
-
-		resp, err := svc.Client.simpleClient.Do(ctx, httpsimple.Request{
-			Method: http.MethodGet,
-			URL:    APIV3URLSearchJQL,
-			Query:  query,
-		})
-		if err != nil {
-			return nil, err
-		}
-		defer resp.Body.Close()
-
-		if resp.StatusCode >= 300 {
-			return nil, fmt.Errorf("jira api status code (%d)", resp.StatusCode)
-		}
-
-		// Read response body
-		body, err := io.ReadAll(resp.Body)
-		if err != nil {
-			return nil, err
-		}
-
-		// Parse the response using the V3 typed structs
-		var v3Response apiv3.IssuesResponse
-		if err := json.Unmarshal(body, &v3Response); err != nil {
-			return nil, err
-		}
-
-		// Convert V3 issues to go-jira Issues
-		for _, v3Issue := range v3Response.Issues {
-			goJiraIssue := v3Issue.ConvertToGoJiraIssue()
-			allIssues = append(allIssues, *goJiraIssue)
-		}
-
-		nextPageToken = strings.TrimSpace(v3Response.NextPageToken)
-		if v3Response.IsLast || nextPageToken == "" {
-			break
-		}
-	}
-
-	return allIssues, nil
+func (svc *IssueService) SearchIssuesAPIV3(ctx context.Context, jql string, retrieveAll bool, opts ...SearchOptions) (Issues, error) {
+	return CollectIssues(svc.SearchIssuesAPIV3Seq(ctx, jql, opts...))
 }
 
-func (svc *IssueService) SearchChildrenIssues(parentKeys []string) (Issues, error) {
+func (svc *IssueService) SearchChildrenIssues(parentKeys []string, opts ...SearchOptions) (Issues, error) {
 	if parentKeys = stringsutil.SliceCondenseSpace(parentKeys, true, true); len(parentKeys) == 0 {
 		return Issues{}, errors.New("parentKeys cannot be empty")
 	} else {
 		jqlInfo := gojira.JQL{ParentsIncl: [][]string{parentKeys}}
-		return svc.SearchIssues(jqlInfo.String(), true)
+		return svc.SearchIssues(jqlInfo.String(), true, opts...)
 	}
 }
 
@@ -277,52 +189,11 @@ func (svc *IssueService) JQLResultsTotalCount(jql string) (int, error) {
 // SearchIssuesPage returns all issues for a JQL query, automatically handling API pagination.
 // A `limit` value of `0` means the max results available. A `maxPages` of `0` means to retrieve
 // all pages.
-func (svc *IssueService) SearchIssuesPages(jql string, limit, offset, maxPages int) (Issues, error) {
-	var issues Issues
-	if limit < 0 || offset < 0 || maxPages < 0 {
-		return issues, errors.New("limit, offset, and maxPages cannot be negative")
-	}
-
-	if limit == 0 {
-		limit = gojira.JQLMaxResults
-	}
-
-	so := jira.SearchOptions{
-		MaxResults: limit,
-		StartAt:    offset}
-
-	i := 0
-	for maxPages == 0 || i < maxPages {
-		ii, resp, err := svc.Client.JiraClient.Issue.Search(jql, &so)
-		if err != nil {
-			return issues, err
-		} else if resp.StatusCode >= 300 {
-			return issues, fmt.Errorf("jira api status code (%d)", resp.StatusCode)
-		}
-		svc.Client.LogOrNotAny(
-			context.Background(),
-			slog.LevelInfo,
-			"jira api iteration (SearchIssuesPages)",
-			"iteration", i,
-			"limit", resp.MaxResults,
-			"offset", resp.StartAt,
-			"total", resp.Total,
-			"jql", jql,
-		)
-		if len(ii) > 0 {
-			issues = append(issues, ii...)
-		}
-		if resp.StartAt+len(ii) >= resp.Total {
-			break
-		}
-		so.StartAt += len(ii)
-		i++
-	}
-
-	return issues, nil
+func (svc *IssueService) SearchIssuesPages(jql string, limit, offset, maxPages int, opts ...SearchOptions) (Issues, error) {
+	return CollectIssues(svc.SearchIssuesPagesSeq(context.Background(), jql, limit, offset, maxPages, opts...))
 }
 
-func (svc *IssueService) SearchIssuesByMonth(jql gojira.JQL, createdGTE, createdLT time.Time, fnExec func(ii Issues, start time.Time) error) error {
+func (svc *IssueService) SearchIssuesByMonth(jql gojira.JQL, createdGTE, createdLT time.Time, fnExec func(ii Issues, start time.Time) error, opts ...SearchOptions) error {
 	if createdGTE.IsZero() {
 		createdGTE = month.MonthStart(time.Now(), 0)
 	}
@@ -339,7 +210,7 @@ func (svc *IssueService) SearchIssuesByMonth(jql gojira.JQL, createdGTE, created
 	for createdGTE.Before(createdLT) {
 		jql.CreatedGTE = &createdGTE
 		jql.CreatedLT = pointer.Pointer(month.MonthStart(createdGTE, 1))
-		if ii, err := svc.SearchIssues(jql.String(), true); err != nil {
+		if ii, err := svc.SearchIssues(jql.String(), true, opts...); err != nil {
 			return err
 		} else if err := fnExec(ii, createdGTE); err != nil {
 			return err
@@ -350,8 +221,8 @@ func (svc *IssueService) SearchIssuesByMonth(jql gojira.JQL, createdGTE, created
 	return nil
 }
 
-func (svc *IssueService) SearchIssuesSet(jql string) (*IssuesSet, error) {
-	if ii, err := svc.SearchIssues(jql, true); err != nil {
+func (svc *IssueService) SearchIssuesSet(jql string, opts ...SearchOptions) (*IssuesSet, error) {
+	if ii, err := svc.SearchIssues(jql, true, opts...); err != nil {
 		return nil, err
 	} else {
 		is := NewIssuesSet(svc.Client.Config)
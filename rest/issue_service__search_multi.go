@@ -0,0 +1,132 @@
+package rest
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+	"slices"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/grokify/gojira"
+)
+
+// SearchMultiWorkersDefault is the number of queries run at once by
+// `SearchIssuesMultiConcurrent` when no worker count is set.
+const SearchMultiWorkersDefault = 4
+
+// SearchMultiOptions configures `SearchIssuesMultiConcurrent`.
+type SearchMultiOptions struct {
+	Workers       int           // queries run at once; 0 uses `SearchMultiWorkersDefault`
+	SearchOptions SearchOptions // fields, expansions and page size used for every query
+}
+
+// WorkersOrDefault returns the worker count, or `SearchMultiWorkersDefault` if it is not set.
+func (opts *SearchMultiOptions) WorkersOrDefault() int {
+	if opts == nil || opts.Workers <= 0 {
+		return SearchMultiWorkersDefault
+	}
+	return opts.Workers
+}
+
+// JQLError is the error for a single query of a multi-query search.
+type JQLError struct {
+	Index int    // position of the query in the input
+	JQL   string // query string
+	Err   error
+}
+
+func (e *JQLError) Error() string {
+	return fmt.Sprintf("jql query %d failed (%s): %s", e.Index, e.JQL, e.Err.Error())
+}
+
+func (e *JQLError) Unwrap() error { return e.Err }
+
+// SearchIssuesMultiConcurrent runs the queries in jqls using a bounded pool of workers and
+// deduplicates the results by key into an `IssuesSet`. A failed query does not stop the others:
+// the returned error joins a `*JQLError` for each failed query, and the set contains the issues of
+// all queries that succeeded. The returned `JQLs` are a copy of jqls with `Meta.QueryTime` and
+// `Meta.QueryTotalCount` set for each successful query. Empty queries return no issues.
+func (svc *IssueService) SearchIssuesMultiConcurrent(ctx context.Context, jqls gojira.JQLs, opts *SearchMultiOptions) (*IssuesSet, gojira.JQLs, error) {
+	if svc.Client == nil {
+		return nil, jqls, ErrClientCannotBeNil
+	}
+	out := slices.Clone(jqls)
+	set := NewIssuesSet(svc.Client.Config)
+	if len(out) == 0 {
+		return set, out, nil
+	}
+	var sopts SearchOptions
+	if opts != nil {
+		sopts = opts.SearchOptions
+	}
+
+	var (
+		mu      sync.Mutex
+		jqlErrs []*JQLError
+		wg      sync.WaitGroup
+		done    int
+	)
+	indexes := make(chan int)
+	for range min(opts.WorkersOrDefault(), len(out)) {
+		wg.Go(func() {
+			for i := range indexes {
+				jql := strings.TrimSpace(out[i].String())
+				queryTime := time.Now()
+				ii := Issues{}
+				var err error
+				if jql != "" {
+					ii, err = CollectIssues(svc.SearchIssuesSeq(ctx, jql, sopts))
+				}
+				mu.Lock()
+				if err == nil {
+					err = set.Add(ii...)
+				}
+				if err != nil {
+					jqlErrs = append(jqlErrs, &JQLError{Index: i, JQL: jql, Err: err})
+				} else {
+					out[i].Meta.QueryTime = queryTime
+					out[i].Meta.QueryTotalCount = len(ii)
+				}
+				done++
+				svc.Client.LogOrNotAny(
+					ctx,
+					slog.LevelInfo,
+					"jira api iteration (SearchIssuesMultiConcurrent)",
+					"jql", jql,
+					"index", i,
+					"count", len(ii),
+					"completedQueries", done,
+					"totalQueries", len(out),
+					"totalIssues", set.Len(),
+				)
+				mu.Unlock()
+			}
+		})
+	}
+	for i := range out {
+		indexes <- i
+	}
+	close(indexes)
+	wg.Wait()
+
+	slices.SortFunc(jqlErrs, func(a, b *JQLError) int { return a.Index - b.Index })
+	var errs []error
+	for _, err := range jqlErrs {
+		errs = append(errs, err)
+	}
+	return set, out, errors.Join(errs...)
+}
+
+// SearchIssuesMultiConcurrentStrings is `SearchIssuesMultiConcurrent` for JQL strings, such as
+// those returned by `gojira.JQLStringsSimple`. Each string is returned as a `gojira.JQL`
+// with the string as its only `Raw` condition.
+func (svc *IssueService) SearchIssuesMultiConcurrentStrings(ctx context.Context, jqls []string, opts *SearchMultiOptions) (*IssuesSet, gojira.JQLs, error) {
+	var js gojira.JQLs
+	for _, jql := range jqls {
+		js = append(js, gojira.JQL{Raw: []string{jql}})
+	}
+	return svc.SearchIssuesMultiConcurrent(ctx, js, opts)
+}
@@ -0,0 +1,64 @@
+package rest
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	jira "github.com/andygrunwald/go-jira"
+)
+
+func TestSearchIssuesMultiConcurrent(t *testing.T) {
+	results := map[string][]string{
+		"project = ABC": {"ABC-1", "ABC-2"},
+		"project = DEF": {"DEF-1"},
+		"key = ABC-1":   {"ABC-1"},
+	}
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		keys, ok := results[r.URL.Query().Get("jql")]
+		if !ok {
+			w.WriteHeader(http.StatusBadRequest)
+			_, _ = w.Write([]byte(`{"errorMessages":["Error in the JQL Query"]}`))
+			return
+		}
+		var ii []jira.Issue
+		for _, key := range keys {
+			ii = append(ii, jira.Issue{Key: key})
+		}
+		w.Header().Set("Content-Type", "application/json")
+		if err := json.NewEncoder(w).Encode(map[string]any{"isLast": true, "issues": ii}); err != nil {
+			t.Errorf("failed to encode search result: %v", err)
+		}
+	}))
+	defer server.Close()
+
+	jiraClient, err := jira.NewClient(nil, server.URL)
+	if err != nil {
+		t.Fatalf("failed to create jira client: %v", err)
+	}
+	svc := NewIssueService(&Client{JiraClient: jiraClient})
+
+	set, jqls, err := svc.SearchIssuesMultiConcurrentStrings(context.Background(),
+		[]string{"project = ABC", "project = ZZZ (", "project = DEF", "key = ABC-1"},
+		&SearchMultiOptions{Workers: 2})
+
+	var jqlErr *JQLError
+	if !errors.As(err, &jqlErr) || jqlErr.Index != 1 || !IsValidation(err) {
+		t.Errorf("SearchIssuesMultiConcurrentStrings() error mismatch: want (*JQLError index 1), got (%v)", err)
+	}
+	if set.Len() != 3 {
+		t.Errorf("SearchIssuesMultiConcurrentStrings() issues mismatch: want (3), got (%d)", set.Len())
+	}
+	wantCounts := []int{2, 0, 1, 1}
+	for i, want := range wantCounts {
+		if got := jqls[i].Meta.QueryTotalCount; got != want {
+			t.Errorf("SearchIssuesMultiConcurrentStrings() count [%d] mismatch: want (%d), got (%d)", i, want, got)
+		}
+		if succeeded := !jqls[i].Meta.QueryTime.IsZero(); succeeded != (i != 1) {
+			t.Errorf("SearchIssuesMultiConcurrentStrings() query time [%d] mismatch: want set (%v), got (%v)", i, i != 1, succeeded)
+		}
+	}
+}
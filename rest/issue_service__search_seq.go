@@ -0,0 +1,217 @@
+package rest
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"iter"
+	"log/slog"
+	"net/http"
+	"strings"
+
+	jira "github.com/andygrunwald/go-jira"
+	"github.com/grokify/gojira/rest/apiv3"
+	"github.com/grokify/mogo/net/http/httpsimple"
+
+	"github.com/grokify/gojira"
+)
+
+// SearchIssuesSeq returns an iterator over the issues for a JQL query using the `/rest/api/2/search/jql`
+// endpoint. Pages are requested as the iterator is consumed, so only one page is held in memory
+// and stopping early avoids requesting the remaining pages. Iteration ends after the first error,
+// which is yielded with a zero `jira.Issue`. Context cancellation is checked between issues.
+// Without options, all fields are requested with the `epic` expansion, 50 issues per page.
+func (svc *IssueService) SearchIssuesSeq(ctx context.Context, jql string, opts ...SearchOptions) iter.Seq2[jira.Issue, error] {
+	so := searchOptionsOrDefault(opts)
+	return func(yield func(jira.Issue, error) bool) {
+		if svc.Client == nil || svc.Client.JiraClient == nil {
+			yield(jira.Issue{}, ErrJiraClientCannotBeNil)
+			return
+		}
+		opts := &jira.SearchOptionsV2{
+			MaxResults: so.PageSizeOrDefault(50),
+			Expand:     so.ExpandOrDefault(ExpandFieldEpic), // CSV
+			Fields:     so.FieldsOrDefault(),
+		}
+		for {
+			ii, resp, err := svc.Client.JiraClient.Issue.SearchV2JQLWithContext(ctx, jql, opts)
+			if err != nil {
+				yield(jira.Issue{}, JiraResponseError(resp, err))
+				return
+			} else if resp.StatusCode > 299 {
+				yield(jira.Issue{}, JiraResponseError(resp, nil))
+				return
+			}
+			if !yieldIssues(ctx, ii, yield) {
+				return
+			}
+			if resp.IsLast || strings.TrimSpace(resp.NextPageToken) == "" {
+				return
+			}
+			opts.NextPageToken = resp.NextPageToken
+		}
+	}
+}
+
+// SearchIssuesAPIV3Seq returns an iterator over the issues for a JQL query using the V3 API
+// endpoint `/rest/api/3/search/jql`. Pages are requested as the iterator is consumed. See
+// `SearchIssuesSeq` for error and cancellation handling. Without options, all fields are
+// requested, `MaxResults` issues per page.
+func (svc *IssueService) SearchIssuesAPIV3Seq(ctx context.Context, jql string, opts ...SearchOptions) iter.Seq2[jira.Issue, error] {
+	so := searchOptionsOrDefault(opts)
+	return func(yield func(jira.Issue, error) bool) {
+		if svc.Client == nil {
+			yield(jira.Issue{}, ErrClientCannotBeNil)
+			return
+		} else if svc.Client.simpleClient == nil {
+			yield(jira.Issue{}, ErrSimpleClientCannotBeNil)
+			return
+		} else if strings.TrimSpace(jql) == "" {
+			return
+		}
+		nextPageToken := ""
+		for {
+			v3Response, err := svc.searchIssuesAPIV3Page(ctx, jql, so, nextPageToken)
+			if err != nil {
+				yield(jira.Issue{}, err)
+				return
+			}
+			// Convert V3 issues to go-jira Issues
+			for _, v3Issue := range v3Response.Issues {
+				if err := ctx.Err(); err != nil {
+					yield(jira.Issue{}, err)
+					return
+				} else if !yield(*v3Issue.ConvertToGoJiraIssue(), nil) {
+					return
+				}
+			}
+			nextPageToken = strings.TrimSpace(v3Response.NextPageToken)
+			if v3Response.IsLast || nextPageToken == "" {
+				return
+			}
+		}
+	}
+}
+
+func (svc *IssueService) searchIssuesAPIV3Page(ctx context.Context, jql string, so SearchOptions, nextPageToken string) (*apiv3.IssuesResponse, error) {
+	query := map[string][]string{
+		"jql":        {jql},
+		"maxResults": {fmt.Sprintf("%d", so.PageSizeOrDefault(MaxResults))},
+		"fields":     {strings.Join(so.FieldsOrDefault(), ",")},
+	}
+	if expand := so.ExpandOrDefault(""); expand != "" {
+		query["expand"] = []string{expand}
+	}
+	if nextPageToken != "" {
+		query["nextPageToken"] = []string{nextPageToken}
+	}
+
+	resp, err := svc.Client.simpleClient.Do(ctx, httpsimple.Request{
+		Method: http.MethodGet,
+		URL:    APIV3URLSearchJQL,
+		Query:  query,
+	})
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	if err := CheckResponse(resp); err != nil {
+		return nil, err
+	}
+
+	// Read response body
+	body, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return nil, err
+	}
+
+	// Parse the response using the V3 typed structs
+	var v3Response apiv3.IssuesResponse
+	if err := json.Unmarshal(body, &v3Response); err != nil {
+		return nil, err
+	}
+	return &v3Response, nil
+}
+
+// SearchIssuesPagesSeq returns an iterator over the issues for a JQL query using the legacy
+// `startAt` pagination API. A `limit` value of `0` means the max results available. A `maxPages`
+// of `0` means to retrieve all pages. If `limit` is `0`, the options' page size is used if set.
+// See `SearchIssuesSeq` for error and cancellation handling.
+func (svc *IssueService) SearchIssuesPagesSeq(ctx context.Context, jql string, limit, offset, maxPages int, opts ...SearchOptions) iter.Seq2[jira.Issue, error] {
+	sopts := searchOptionsOrDefault(opts)
+	return func(yield func(jira.Issue, error) bool) {
+		if limit < 0 || offset < 0 || maxPages < 0 {
+			yield(jira.Issue{}, errors.New("limit, offset, and maxPages cannot be negative"))
+			return
+		} else if svc.Client == nil || svc.Client.JiraClient == nil {
+			yield(jira.Issue{}, ErrJiraClientCannotBeNil)
+			return
+		}
+		if limit == 0 {
+			limit = sopts.PageSizeOrDefault(gojira.JQLMaxResults)
+		}
+
+		so := jira.SearchOptions{
+			MaxResults: limit,
+			StartAt:    offset,
+			Expand:     sopts.ExpandOrDefault(""),
+			Fields:     sopts.Fields}
+
+		for i := 0; maxPages == 0 || i < maxPages; i++ {
+			ii, resp, err := svc.Client.JiraClient.Issue.SearchWithContext(ctx, jql, &so)
+			if err != nil {
+				yield(jira.Issue{}, JiraResponseError(resp, err))
+				return
+			} else if resp.StatusCode >= 300 {
+				yield(jira.Issue{}, JiraResponseError(resp, nil))
+				return
+			}
+			svc.Client.LogOrNotAny(
+				ctx,
+				slog.LevelInfo,
+				"jira api iteration (SearchIssuesPages)",
+				"iteration", i,
+				"limit", resp.MaxResults,
+				"offset", resp.StartAt,
+				"total", resp.Total,
+				"jql", jql,
+			)
+			if !yieldIssues(ctx, ii, yield) {
+				return
+			}
+			if len(ii) == 0 || resp.StartAt+len(ii) >= resp.Total {
+				return
+			}
+			so.StartAt += len(ii)
+		}
+	}
+}
+
+// yieldIssues yields each issue, returning false if the consumer stopped or ctx is done.
+func yieldIssues(ctx context.Context, ii []jira.Issue, yield func(jira.Issue, error) bool) bool {
+	for _, iss := range ii {
+		if err := ctx.Err(); err != nil {
+			yield(jira.Issue{}, err)
+			return false
+		} else if !yield(iss, nil) {
+			return false
+		}
+	}
+	return true
+}
+
+// CollectIssues reads all issues from seq into `Issues`. On error, the issues collected
+// so far are returned with the error.
+func CollectIssues(seq iter.Seq2[jira.Issue, error]) (Issues, error) {
+	issues := Issues{}
+	for iss, err := range seq {
+		if err != nil {
+			return issues, err
+		}
+		issues = append(issues, iss)
+	}
+	return issues, nil
+}
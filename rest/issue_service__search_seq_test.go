@@ -0,0 +1,136 @@
+package rest
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"sync/atomic"
+	"testing"
+
+	jira "github.com/andygrunwald/go-jira"
+)
+
+// newSearchPagesTestServer serves `pages` pages of two issues each from the
+// `/rest/api/2/search/jql` endpoint and counts requests.
+func newSearchPagesTestServer(t *testing.T, pages int) (*IssueService, *atomic.Int32) {
+	t.Helper()
+	var calls atomic.Int32
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		calls.Add(1)
+		page := 0
+		if tok := r.URL.Query().Get("nextPageToken"); tok != "" {
+			if _, err := fmt.Sscanf(tok, "page-%d", &page); err != nil {
+				t.Errorf("invalid nextPageToken (%s)", tok)
+			}
+		}
+		res := map[string]any{
+			"isLast": page == pages-1,
+			"issues": []jira.Issue{
+				{Key: fmt.Sprintf("ABC-%d", page*2+1)},
+				{Key: fmt.Sprintf("ABC-%d", page*2+2)}}}
+		if page < pages-1 {
+			res["nextPageToken"] = fmt.Sprintf("page-%d", page+1)
+		}
+		w.Header().Set("Content-Type", "application/json")
+		if err := json.NewEncoder(w).Encode(res); err != nil {
+			t.Errorf("failed to encode search result: %v", err)
+		}
+	}))
+	t.Cleanup(server.Close)
+
+	jiraClient, err := jira.NewClient(nil, server.URL)
+	if err != nil {
+		t.Fatalf("failed to create jira client: %v", err)
+	}
+	return NewIssueService(&Client{JiraClient: jiraClient}), &calls
+}
+
+func TestSearchIssuesSeq(t *testing.T) {
+	svc, calls := newSearchPagesTestServer(t, 3)
+	issues, err := CollectIssues(svc.SearchIssuesSeq(context.Background(), "project = ABC"))
+	if err != nil {
+		t.Fatalf("SearchIssuesSeq() error = %v", err)
+	}
+	if len(issues) != 6 || issues[5].Key != "ABC-6" || calls.Load() != 3 {
+		t.Errorf("SearchIssuesSeq() mismatch: want (6 issues, last ABC-6, 3 calls), got (%d issues, %d calls)", len(issues), calls.Load())
+	}
+}
+
+func TestSearchIssuesSeqStopEarly(t *testing.T) {
+	svc, calls := newSearchPagesTestServer(t, 3)
+	count := 0
+	for _, err := range svc.SearchIssuesSeq(context.Background(), "project = ABC") {
+		if err != nil {
+			t.Fatalf("SearchIssuesSeq() error = %v", err)
+		}
+		count++
+		if count == 3 {
+			break
+		}
+	}
+	if calls.Load() != 2 {
+		t.Errorf("SearchIssuesSeq() stop early calls mismatch: want (2), got (%d)", calls.Load())
+	}
+}
+
+func TestSearchIssuesSeqContextCanceled(t *testing.T) {
+	svc, _ := newSearchPagesTestServer(t, 3)
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	count := 0
+	var errLast error
+	for _, err := range svc.SearchIssuesSeq(ctx, "project = ABC") {
+		if err != nil {
+			errLast = err
+			break
+		}
+		count++
+		cancel()
+	}
+	if count != 1 || !errors.Is(errLast, context.Canceled) {
+		t.Errorf("SearchIssuesSeq() canceled mismatch: want (1, %v), got (%d, %v)", context.Canceled, count, errLast)
+	}
+}
+
+func TestSearchIssuesSeqOptions(t *testing.T) {
+	tests := []struct {
+		opts       []SearchOptions
+		wantFields string
+		wantExpand string
+		wantMax    string
+	}{
+		{nil, "*all", "epic", "50"},
+		{[]SearchOptions{{Fields: []string{"key", " summary ", "key"}, Expand: []string{"changelog", "names"}, PageSize: 10}},
+			"key,summary", "changelog,names", "10"},
+	}
+	for _, tt := range tests {
+		var query url.Values
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			query = r.URL.Query()
+			w.Header().Set("Content-Type", "application/json")
+			_, _ = w.Write([]byte(`{"isLast":true,"issues":[]}`))
+		}))
+		jiraClient, err := jira.NewClient(nil, server.URL)
+		if err != nil {
+			t.Fatalf("failed to create jira client: %v", err)
+		}
+		svc := NewIssueService(&Client{JiraClient: jiraClient})
+		if _, err := svc.SearchIssues("project = ABC", true, tt.opts...); err != nil {
+			t.Fatalf("SearchIssues() error = %v", err)
+		}
+		server.Close()
+		if got := query.Get("fields"); got != tt.wantFields {
+			t.Errorf("SearchIssues() fields mismatch: want (%s), got (%s)", tt.wantFields, got)
+		}
+		if got := query.Get("expand"); got != tt.wantExpand {
+			t.Errorf("SearchIssues() expand mismatch: want (%s), got (%s)", tt.wantExpand, got)
+		}
+		if got := query.Get("maxResults"); got != tt.wantMax {
+			t.Errorf("SearchIssues() maxResults mismatch: want (%s), got (%s)", tt.wantMax, got)
+		}
+	}
+}
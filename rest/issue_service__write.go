@@ -3,13 +3,12 @@ package rest
 import (
 	"context"
 	"errors"
-	"fmt"
-	"io"
 	"log/slog"
 	"net/http"
 	"strings"
 
 	jira "github.com/andygrunwald/go-jira"
+	"github.com/grokify/mogo/errors/errorsutil"
 	"github.com/grokify/mogo/net/http/httpsimple"
 	"github.com/grokify/mogo/net/urlutil"
 	"github.com/grokify/mogo/pointer"
@@ -89,7 +88,8 @@ type IssuePatchRequestBodyField struct {
 	Child *IssuePatchRequestBodyField `json:"child,omitempty"`
 }
 
-// IssuePatch updates fields for an issue. See more here:
+// IssuePatch updates fields for an issue. A non-2xx response is returned with an
+// `*APIError` describing the rejected fields. See more here:
 // https://community.developer.atlassian.com/t/update-issue-custom-field-value-via-api-without-going-forge/71161
 func (svc *IssueService) IssuePatch(ctx context.Context, issueKeyOrID string, issueUpdateRequestBody IssuePatchRequestBody) (*http.Response, error) {
 	if err := issueUpdateRequestBody.Validate(); err != nil {
@@ -98,12 +98,14 @@ func (svc *IssueService) IssuePatch(ctx context.Context, issueKeyOrID string, is
 		return nil, errors.New("issue key or id must be provided")
 	} else if issueUpdateRequestBody.Update == nil && len(issueUpdateRequestBody.Fields) == 0 {
 		return nil, errors.New("issue `update` or `fields` must be provided")
+	} else if resp, err := svc.Client.simpleClient.Do(ctx, httpsimple.Request{
+		Method:   http.MethodPut, // This only updates certain fields but uses a PUT http method.
+		URL:      urlutil.JoinAbsolute(APIV3URLIssue, issueKeyOrID),
+		Body:     issueUpdateRequestBody,
+		BodyType: httpsimple.BodyTypeJSON}); err != nil {
+		return resp, err
 	} else {
-		return svc.Client.simpleClient.Do(ctx, httpsimple.Request{
-			Method:   http.MethodPut, // This only updates certain fields but uses a PUT http method.
-			URL:      urlutil.JoinAbsolute(APIV3URLIssue, issueKeyOrID),
-			Body:     issueUpdateRequestBody,
-			BodyType: httpsimple.BodyTypeJSON})
+		return resp, CheckResponse(resp)
 	}
 }
 
@@ -174,14 +176,8 @@ func (svc *IssueService) IssuePatchCustomFieldRecursive(ctx context.Context, iss
 				"customFieldLabel", customFieldLabel,
 				"customFieldValue", customFieldValue)
 			reqBody := NewIssuePatchRequestBodyCustomField(customFieldLabel, customFieldValue)
-			if resp, err := svc.IssuePatch(ctx, issueKeyOrID, reqBody); err != nil {
-				return 0, nil
-			} else if resp.StatusCode >= 300 {
-				body, err := io.ReadAll(resp.Body)
-				if err != nil {
-					body = []byte(err.Error())
-				}
-				return 0, fmt.Errorf("key (%s) status code (%d) bodyOrErr (%s)", im.Key(), resp.StatusCode, string(body))
+			if _, err := svc.IssuePatch(ctx, issueKeyOrID, reqBody); err != nil {
+				return 0, errorsutil.Wrapf(err, "key (%s)", im.Key())
 			} else {
 				count++
 			}
@@ -295,14 +291,8 @@ func (svc *IssueService) IssuePatchLabelRecursive(ctx context.Context, issueKeyO
 				"labelAction", labelOperation,
 				"label", label)
 			reqBody := NewIssuePatchRequestBodyLabelAddRemove(label, removeLabel)
-			if resp, err := svc.IssuePatch(ctx, issueKeyOrID, reqBody); err != nil {
-				return count, err
-			} else if resp.StatusCode >= 300 {
-				body, err := io.ReadAll(resp.Body)
-				if err != nil {
-					body = []byte(err.Error())
-				}
-				return count, fmt.Errorf("key (%s) status code (%d) bodyOrErr (%s)", im.Key(), resp.StatusCode, string(body))
+			if _, err := svc.IssuePatch(ctx, issueKeyOrID, reqBody); err != nil {
+				return count, errorsutil.Wrapf(err, "key (%s)", im.Key())
 			} else {
 				count++
 			}
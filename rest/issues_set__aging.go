@@ -0,0 +1,205 @@
+package rest
+
+import (
+	"errors"
+	"fmt"
+	"sort"
+	"time"
+
+	"github.com/grokify/gocharts/v2/data/table"
+	"github.com/grokify/mogo/pointer"
+)
+
+var ErrNoInProgressStatuses = errors.New("no in-progress statuses: set `AgingWIPOptions.InProgressStatuses` or a `StatusCategoryConfig`")
+
+// Aging levels: the highest cycle-time percentile an issue's in-progress age exceeds.
+const (
+	AgingLevelP50 = "p50"
+	AgingLevelP85 = "p85"
+	AgingLevelP95 = "p95"
+
+	AgingPercentileDefault = 85
+)
+
+// AgingWIPOptions configures `IssuesSet.AgingWIP`. Empty statuses are read from the `StatusCategoryConfig`
+// of the `IssuesSet` config. A nil `Baseline` is computed from the done issues in the set.
+type AgingWIPOptions struct {
+	Now                time.Time                // zero uses now
+	InProgressStatuses []string                 // statuses that are work in progress
+	DoneStatuses       []string                 // statuses that complete work, for the baseline
+	Baseline           map[string]DurationStats // cycle-time stats by issue type, with `FlowGroupAll` as fallback. See `CycleTimeBaseline`.
+	Percentile         int                      // outlier threshold: 50, 85 or 95; zero uses `AgingPercentileDefault`
+}
+
+// AgingWIPItem is the age of an issue that is in progress, compared to the cycle times of
+// completed issues of the same type.
+type AgingWIPItem struct {
+	Key               string        `json:"key"`
+	Summary           string        `json:"summary"`
+	Type              string        `json:"type"`
+	Status            string        `json:"status"`
+	Stage             string        `json:"stage"`
+	Assignee          string        `json:"assignee,omitempty"`
+	StageEntered      time.Time     `json:"stageEntered"`
+	StageAge          time.Duration `json:"stageAge"`
+	InProgressEntered time.Time     `json:"inProgressEntered"`
+	InProgressAge     time.Duration `json:"inProgressAge"`
+	BaselineGroup     string        `json:"baselineGroup,omitempty"`
+	BaselineCount     int           `json:"baselineCount"`
+	P50               time.Duration `json:"p50,omitempty"`
+	P85               time.Duration `json:"p85,omitempty"`
+	P95               time.Duration `json:"p95,omitempty"`
+	Level             string        `json:"level,omitempty"`
+	Outlier           bool          `json:"outlier"`
+}
+
+// CycleTimeBaseline returns cycle-time statistics by issue type, plus all types under `FlowGroupAll`.
+func CycleTimeBaseline(fts []IssueFlowTime) (map[string]DurationStats, error) {
+	groupFunc, err := FlowTimeGroupFuncBySlug(FieldSlugType)
+	if err != nil {
+		return nil, err
+	}
+	out, err := FlowTimesStats(fts, FlowMetricCycleTime, groupFunc)
+	if err != nil {
+		return nil, err
+	}
+	all, err := FlowTimesStats(fts, FlowMetricCycleTime, nil)
+	if err != nil {
+		return nil, err
+	}
+	if s, ok := all[FlowGroupAll]; ok {
+		out[FlowGroupAll] = s
+	}
+	return out, nil
+}
+
+// AgingWIP returns the issues whose current status is in progress, oldest first. The stage age runs
+// from entry into the current meta stage, or status if it has no meta stage, and the in-progress age
+// from the first entry into an in-progress status, as for cycle time. The in-progress age is compared
+// to the baseline for the issue type, or `FlowGroupAll` if the type has none, and the issue is an
+// outlier if it is older than the `Percentile` cycle time. Ages are read from changelogs; issues
+// without a changelog are aged from creation.
+func (set *IssuesSet) AgingWIP(opts *AgingWIPOptions) ([]AgingWIPItem, error) {
+	if opts == nil {
+		opts = &AgingWIPOptions{}
+	}
+	percentile := opts.Percentile
+	if percentile == 0 {
+		percentile = AgingPercentileDefault
+	} else if percentile != 50 && percentile != 85 && percentile != 95 {
+		return nil, fmt.Errorf("aging percentile not supported (%d): use 50, 85 or 95", percentile)
+	}
+	now := opts.Now
+	if now.IsZero() {
+		now = time.Now()
+	}
+	inProgress, done := opts.InProgressStatuses, opts.DoneStatuses
+	metaStage := func(s string) string { return s }
+	if set.Config != nil && set.Config.StatusConfig != nil {
+		scc := set.Config.StatusConfig
+		if len(inProgress) == 0 {
+			inProgress = scc.StatusesInProgress()
+		}
+		if len(done) == 0 {
+			done = scc.StatusesDone()
+		}
+		metaStage = func(s string) string {
+			if ms := scc.MetaStage(s); ms != "" {
+				return ms
+			}
+			return s
+		}
+	}
+	if len(inProgress) == 0 {
+		return nil, ErrNoInProgressStatuses
+	}
+	baseline := opts.Baseline
+	if baseline == nil {
+		fts, err := set.FlowTimes(&FlowTimesOptions{StartStatuses: inProgress, DoneStatuses: done})
+		if err != nil {
+			return nil, err
+		}
+		if baseline, err = CycleTimeBaseline(fts); err != nil {
+			return nil, err
+		}
+	}
+
+	isInProgress := statusMatcher(inProgress)
+	var out []AgingWIPItem
+	for _, key := range set.Keys() {
+		iss := pointer.Pointer(set.Items[key])
+		im := NewIssueMore(iss)
+		if !isInProgress(im.Status()) {
+			continue
+		}
+		sh, err := NewStatusHistory(iss)
+		if err != nil {
+			return nil, err
+		}
+		periods := sh.Periods(now)
+		item := AgingWIPItem{
+			Key:      im.Key(),
+			Summary:  im.Summary(),
+			Type:     im.Type(),
+			Status:   im.Status(),
+			Stage:    metaStage(im.Status()),
+			Assignee: im.AssigneeName()}
+		item.StageEntered = periods[len(periods)-1].Start
+		for i := len(periods) - 2; i >= 0 && metaStage(periods[i].Status) == item.Stage; i-- {
+			item.StageEntered = periods[i].Start
+		}
+		for _, p := range periods {
+			if isInProgress(p.Status) {
+				item.InProgressEntered = p.Start
+				break
+			}
+		}
+		item.StageAge = now.Sub(item.StageEntered)
+		item.InProgressAge = now.Sub(item.InProgressEntered)
+
+		stats, ok := baseline[item.Type]
+		item.BaselineGroup = item.Type
+		if !ok || stats.Count == 0 {
+			stats, ok = baseline[FlowGroupAll]
+			item.BaselineGroup = FlowGroupAll
+		}
+		if ok && stats.Count > 0 {
+			item.BaselineCount = stats.Count
+			item.P50, item.P85, item.P95 = stats.P50, stats.P85, stats.P95
+			switch {
+			case item.InProgressAge > stats.P95:
+				item.Level = AgingLevelP95
+			case item.InProgressAge > stats.P85:
+				item.Level = AgingLevelP85
+			case item.InProgressAge > stats.P50:
+				item.Level = AgingLevelP50
+			}
+			threshold := map[int]time.Duration{50: stats.P50, 85: stats.P85, 95: stats.P95}[percentile]
+			item.Outlier = item.InProgressAge > threshold
+		} else {
+			item.BaselineGroup = ""
+		}
+		out = append(out, item)
+	}
+	sort.SliceStable(out, func(i, j int) bool {
+		return out[i].InProgressAge > out[j].InProgressAge
+	})
+	return out, nil
+}
+
+// AgingWIPTable returns a table of aging work in progress with ages in days.
+func AgingWIPTable(items []AgingWIPItem) *table.Table {
+	tbl := table.NewTable("aging wip")
+	tbl.Columns = []string{"Key", "Summary", "Type", "Status", "Stage", "Assignee",
+		"Stage Age Days", "In Progress Age Days", "Baseline", "P50 Days", "P85 Days", "P95 Days", "Level", "Outlier"}
+	tbl.FormatMap = map[int]string{6: table.FormatFloat, 7: table.FormatFloat}
+	for _, item := range items {
+		row := []string{item.Key, item.Summary, item.Type, item.Status, item.Stage, item.Assignee,
+			formatDays(item.StageAge), formatDays(item.InProgressAge), item.BaselineGroup, "", "", "", item.Level, fmt.Sprintf("%t", item.Outlier)}
+		if item.BaselineCount > 0 {
+			row[9], row[10], row[11] = formatDays(item.P50), formatDays(item.P85), formatDays(item.P95)
+		}
+		tbl.Rows = append(tbl.Rows, row)
+	}
+	return &tbl
+}
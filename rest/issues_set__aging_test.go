@@ -0,0 +1,50 @@
+package rest
+
+import (
+	"testing"
+	"time"
+
+	jira "github.com/andygrunwald/go-jira"
+)
+
+func TestAgingWIP(t *testing.T) {
+	done := statusHistoryTestIssue()
+	done.Fields.Type = jira.IssueType{Name: "Story"}
+	wip := &jira.Issue{
+		Key: "ABC-2",
+		Fields: &jira.IssueFields{
+			Type:    jira.IssueType{Name: "Story"},
+			Created: jira.Time(time.Date(2026, 1, 1, 9, 0, 0, 0, time.UTC)),
+			Status:  &jira.Status{Name: "In Review"}},
+		Changelog: &jira.Changelog{Histories: []jira.ChangelogHistory{
+			{Created: "2026-01-03T09:00:00.000+0000", Items: []jira.ChangelogItems{{Field: "status", FromString: "To Do", ToString: "In Progress"}}},
+			{Created: "2026-01-05T09:00:00.000+0000", Items: []jira.ChangelogItems{{Field: "status", FromString: "In Progress", ToString: "In Review"}}},
+		}}}
+	set := NewIssuesSet(nil)
+	if err := set.Add(*done, *wip); err != nil {
+		t.Fatalf("IssuesSet.Add() error = %v", err)
+	}
+
+	if _, err := set.AgingWIP(nil); err != ErrNoInProgressStatuses {
+		t.Errorf("IssuesSet.AgingWIP() error mismatch: want (%v), got (%v)", ErrNoInProgressStatuses, err)
+	}
+	day := 24 * time.Hour
+	items, err := set.AgingWIP(&AgingWIPOptions{
+		Now:                time.Date(2026, 1, 10, 9, 0, 0, 0, time.UTC),
+		InProgressStatuses: []string{"In Progress", "In Review"},
+		DoneStatuses:       []string{"Done"}})
+	if err != nil {
+		t.Fatalf("IssuesSet.AgingWIP() error = %v", err)
+	} else if len(items) != 1 {
+		t.Fatalf("IssuesSet.AgingWIP() mismatch: want (1 item), got (%d)", len(items))
+	}
+	item := items[0]
+	if item.Key != "ABC-2" || item.Stage != "In Review" || item.StageAge != 5*day || item.InProgressAge != 7*day {
+		t.Errorf("IssuesSet.AgingWIP() ages mismatch: want (ABC-2, In Review, %v, %v), got (%s, %s, %v, %v)",
+			5*day, 7*day, item.Key, item.Stage, item.StageAge, item.InProgressAge)
+	}
+	if item.BaselineGroup != "Story" || item.BaselineCount != 1 || item.P85 != 2*day || item.Level != AgingLevelP95 || !item.Outlier {
+		t.Errorf("IssuesSet.AgingWIP() baseline mismatch: want (Story, 1, %v, p95, outlier), got (%s, %d, %v, %s, %v)",
+			2*day, item.BaselineGroup, item.BaselineCount, item.P85, item.Level, item.Outlier)
+	}
+}
@@ -0,0 +1,410 @@
+package rest
+
+import (
+	"errors"
+	"fmt"
+	"slices"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	jira "github.com/andygrunwald/go-jira"
+	"github.com/grokify/gocharts/v2/data/table"
+	"github.com/grokify/gocharts/v2/data/timeseries"
+	"github.com/grokify/mogo/pointer"
+	"github.com/grokify/mogo/time/timeutil"
+)
+
+const (
+	ChangelogFieldTimeEstimate = "timeestimate"
+	ChangelogFieldSprint       = "sprint"
+
+	ScopeChangeAdded   = "added"
+	ScopeChangeRemoved = "removed"
+	ScopeChangePoints  = "points"
+
+	BurndownSeriesScopeIssues     = "Scope Issues"
+	BurndownSeriesDoneIssues      = "Done Issues"
+	BurndownSeriesScopePoints     = "Scope Points"
+	BurndownSeriesDonePoints      = "Done Points"
+	BurndownSeriesRemainingPoints = "Remaining Points"
+	BurndownSeriesRemainingEstHrs = "Remaining Estimate Hours"
+)
+
+// BurndownOptions configures `IssuesSet.Burndown`. Without a `SprintID`, every issue in the set
+// is in scope from its creation. With a `SprintID`, issues are in scope while their Sprint field
+// includes the sprint, read from the changelog; issues without Sprint changes are in scope from
+// creation. Done statuses default to the `StatusCategoryConfig` of the `IssuesSet` config; without
+// any, issues are done once resolved.
+type BurndownOptions struct {
+	Start           time.Time // first day, in UTC
+	End             time.Time // last day, in UTC; zero uses now
+	SprintID        int       // sprint whose scope to follow
+	PointsField     string    // story points custom field, e.g. `customfield_10016`
+	PointsFieldName string    // story points name in the changelog, e.g. `Story Points`
+	DoneStatuses    []string
+}
+
+// BurndownDay is the state of the scope at the end of a day, or at `End` on the last day.
+type BurndownDay struct {
+	Date              time.Time     `json:"date"`
+	ScopeIssues       int           `json:"scopeIssues"`
+	DoneIssues        int           `json:"doneIssues"`
+	ScopePoints       float64       `json:"scopePoints"`
+	DonePoints        float64       `json:"donePoints"`
+	RemainingPoints   float64       `json:"remainingPoints"`
+	RemainingEstimate time.Duration `json:"remainingEstimate"`
+}
+
+// ScopeChange is an issue added to or removed from the scope, or a change to the story points of
+// an issue in scope, after `Start`.
+type ScopeChange struct {
+	Time   time.Time `json:"time"`
+	Key    string    `json:"key"`
+	Type   string    `json:"type"`
+	Points float64   `json:"points"` // points added, negative if removed
+}
+
+// Burndown is a day-by-day reconstruction of scope, completed work and remaining work, for burndown
+// and burnup charts.
+type Burndown struct {
+	Start        time.Time     `json:"start"`
+	End          time.Time     `json:"end"`
+	SprintID     int           `json:"sprintId,omitempty"`
+	Days         []BurndownDay `json:"days"`
+	ScopeChanges []ScopeChange `json:"scopeChanges"`
+}
+
+// fieldHistory is the value of a field over time, reconstructed from the changelog.
+type fieldHistory struct {
+	initial string
+	changes []fieldChange
+}
+
+type fieldChange struct {
+	time     time.Time
+	from, to string
+}
+
+// at returns the value at t.
+func (fh fieldHistory) at(t time.Time) string {
+	v := fh.initial
+	for _, c := range fh.changes {
+		if c.time.After(t) {
+			break
+		}
+		v = c.to
+	}
+	return v
+}
+
+// issueFieldHistories returns the changelog changes of the given fields, keyed by lowercase field
+// name. Each field's func reads the raw values or the display strings of a changelog item.
+func issueFieldHistories(iss *jira.Issue, fields map[string]func(jira.ChangelogItems) (from, to string)) (map[string]fieldHistory, error) {
+	out := map[string]fieldHistory{}
+	if iss == nil || iss.Changelog == nil {
+		return out, nil
+	}
+	for _, h := range iss.Changelog.Histories {
+		for _, item := range h.Items {
+			name := strings.ToLower(strings.TrimSpace(item.Field))
+			value, ok := fields[name]
+			if !ok {
+				continue
+			}
+			dt, err := h.CreatedTime()
+			if err != nil {
+				return nil, fmt.Errorf("invalid changelog time for issue (%s): %w", iss.Key, err)
+			}
+			from, to := value(item)
+			fh := out[name]
+			fh.changes = append(fh.changes, fieldChange{time: dt, from: from, to: to})
+			out[name] = fh
+		}
+	}
+	for name, fh := range out {
+		sort.SliceStable(fh.changes, func(i, j int) bool { return fh.changes[i].time.Before(fh.changes[j].time) })
+		fh.initial = fh.changes[0].from
+		out[name] = fh
+	}
+	return out, nil
+}
+
+func changelogItemRaw(item jira.ChangelogItems) (string, string) {
+	return changelogItemValueString(item.From), changelogItemValueString(item.To)
+}
+
+func changelogItemDisplay(item jira.ChangelogItems) (string, string) {
+	return strings.TrimSpace(item.FromString), strings.TrimSpace(item.ToString)
+}
+
+// sprintIDsContain reports whether a comma-separated list of sprint IDs, as in a Sprint changelog
+// item, includes the sprint.
+func sprintIDsContain(ids string, sprintID int) bool {
+	for _, id := range strings.Split(ids, ",") {
+		if n, err := strconv.Atoi(strings.TrimSpace(id)); err == nil && n == sprintID {
+			return true
+		}
+	}
+	return false
+}
+
+func parseFloatOrZero(s string) float64 {
+	f, err := strconv.ParseFloat(strings.TrimSpace(s), 64)
+	if err != nil {
+		return 0
+	}
+	return f
+}
+
+// burndownIssue is the reconstructed history of one issue.
+type burndownIssue struct {
+	key      string
+	created  time.Time
+	resolved time.Time
+	status   StatusHistory
+	estimate fieldHistory // seconds
+	points   fieldHistory
+	sprint   *fieldHistory // sprint IDs; nil if there are no Sprint changes
+}
+
+func (bi burndownIssue) inScope(t time.Time, sprintID int) bool {
+	if bi.created.After(t) {
+		return false
+	} else if sprintID == 0 || bi.sprint == nil {
+		return true
+	}
+	return sprintIDsContain(bi.sprint.at(t), sprintID)
+}
+
+func (bi burndownIssue) statusAt(t time.Time) string {
+	status := bi.status.InitialStatus
+	for _, tr := range bi.status.Transitions {
+		if tr.Time.After(t) {
+			break
+		}
+		status = tr.To
+	}
+	return status
+}
+
+// burndownModel is the reconstructed history of the issues in a set.
+type burndownModel struct {
+	issues       []burndownIssue
+	sprintID     int
+	doneStatuses []string
+	isDone       func(string) bool
+}
+
+// newBurndownModel reads issue history from changelogs. `Start` and `End` are not used.
+func (set *IssuesSet) newBurndownModel(opts BurndownOptions) (*burndownModel, error) {
+	doneStatuses := opts.DoneStatuses
+	if len(doneStatuses) == 0 && set.Config != nil && set.Config.StatusConfig != nil {
+		doneStatuses = set.Config.StatusConfig.StatusesDone()
+	}
+	m := &burndownModel{sprintID: opts.SprintID, doneStatuses: doneStatuses, isDone: statusMatcher(doneStatuses)}
+	pointsName := strings.ToLower(strings.TrimSpace(opts.PointsFieldName))
+
+	fields := map[string]func(jira.ChangelogItems) (string, string){
+		ChangelogFieldTimeEstimate: changelogItemRaw,
+		ChangelogFieldSprint:       changelogItemRaw}
+	if pointsName != "" {
+		fields[pointsName] = changelogItemDisplay
+	}
+	for _, key := range set.Keys() {
+		iss := pointer.Pointer(set.Items[key])
+		im := NewIssueMore(iss)
+		sh, err := NewStatusHistory(iss)
+		if err != nil {
+			return nil, err
+		}
+		fhs, err := issueFieldHistories(iss, fields)
+		if err != nil {
+			return nil, err
+		}
+		bi := burndownIssue{key: im.Key(), created: im.CreateTime(), resolved: im.ResolutionTime(), status: sh}
+		bi.estimate = fhs[ChangelogFieldTimeEstimate]
+		if len(bi.estimate.changes) == 0 && iss.Fields != nil {
+			bi.estimate.initial = strconv.Itoa(iss.Fields.TimeEstimate)
+		}
+		if pointsName != "" {
+			bi.points = fhs[pointsName]
+		}
+		if len(bi.points.changes) == 0 && opts.PointsField != "" && iss.Fields != nil {
+			if f, ok := IssueFieldsCustomFieldFloat(iss.Fields, opts.PointsField); ok {
+				bi.points.initial = strconv.FormatFloat(f, 'f', -1, 64)
+			}
+		}
+		if fh, ok := fhs[ChangelogFieldSprint]; ok {
+			bi.sprint = &fh
+		}
+		m.issues = append(m.issues, bi)
+	}
+	return m, nil
+}
+
+func (m *burndownModel) done(bi burndownIssue, t time.Time) bool {
+	if len(m.doneStatuses) > 0 {
+		return m.isDone(bi.statusAt(t))
+	}
+	return !bi.resolved.IsZero() && !bi.resolved.After(t)
+}
+
+// at returns the scope at t. `Date` is not set.
+func (m *burndownModel) at(t time.Time) BurndownDay {
+	bdd := BurndownDay{}
+	for _, bi := range m.issues {
+		if !bi.inScope(t, m.sprintID) {
+			continue
+		}
+		points := parseFloatOrZero(bi.points.at(t))
+		bdd.ScopeIssues++
+		bdd.ScopePoints += points
+		if m.done(bi, t) {
+			bdd.DoneIssues++
+			bdd.DonePoints += points
+		} else {
+			bdd.RemainingEstimate += time.Duration(parseFloatOrZero(bi.estimate.at(t))) * time.Second
+		}
+	}
+	bdd.RemainingPoints = bdd.ScopePoints - bdd.DonePoints
+	return bdd
+}
+
+// scopeChanges returns the scope changes of all issues after start and up to end, in time order.
+func (m *burndownModel) scopeChanges(start, end time.Time) []ScopeChange {
+	out := []ScopeChange{}
+	for _, bi := range m.issues {
+		out = append(out, bi.scopeChanges(start, end, m.sprintID)...)
+	}
+	sort.SliceStable(out, func(i, j int) bool {
+		if !out[i].Time.Equal(out[j].Time) {
+			return out[i].Time.Before(out[j].Time)
+		}
+		return out[i].Key < out[j].Key
+	})
+	return out
+}
+
+// Burndown reconstructs, for each day from `Start` to `End`, the issues and story points in scope,
+// done and remaining, and the remaining estimate of issues not done. Issue history is read from
+// changelogs; see `IssueService.IssuesSetLoadChangelogs`.
+func (set *IssuesSet) Burndown(opts BurndownOptions) (*Burndown, error) {
+	if opts.Start.IsZero() {
+		return nil, errors.New("burndown start not set")
+	}
+	start := opts.Start.UTC()
+	start = time.Date(start.Year(), start.Month(), start.Day(), 0, 0, 0, 0, time.UTC)
+	end := opts.End
+	if end.IsZero() {
+		end = time.Now()
+	}
+	end = end.UTC()
+	if end.Before(start) {
+		return nil, errors.New("burndown end is before start")
+	}
+	m, err := set.newBurndownModel(opts)
+	if err != nil {
+		return nil, err
+	}
+
+	bd := &Burndown{Start: start, End: end, SprintID: opts.SprintID, Days: []BurndownDay{}}
+	for day := start; !day.After(end); day = day.AddDate(0, 0, 1) {
+		sample := day.AddDate(0, 0, 1).Add(-time.Nanosecond)
+		if sample.After(end) {
+			sample = end
+		}
+		bdd := m.at(sample)
+		bdd.Date = day
+		bd.Days = append(bd.Days, bdd)
+	}
+	bd.ScopeChanges = m.scopeChanges(start, end)
+	return bd, nil
+}
+
+// scopeChanges returns the times the issue entered or left the scope, or changed points while in
+// scope, after start and up to end.
+func (bi burndownIssue) scopeChanges(start, end time.Time, sprintID int) []ScopeChange {
+	var times []time.Time
+	if bi.created.After(start) {
+		times = append(times, bi.created)
+	}
+	if bi.sprint != nil && sprintID != 0 {
+		for _, c := range bi.sprint.changes {
+			times = append(times, c.time)
+		}
+	}
+	for _, c := range bi.points.changes {
+		times = append(times, c.time)
+	}
+	slices.SortFunc(times, func(a, b time.Time) int { return a.Compare(b) })
+	var out []ScopeChange
+	for i, t := range times {
+		if !t.After(start) || t.After(end) || (i > 0 && t.Equal(times[i-1])) {
+			continue
+		}
+		before := t.Add(-time.Nanosecond)
+		wasIn, isIn := bi.inScope(before, sprintID), bi.inScope(t, sprintID)
+		pointsBefore, pointsAfter := parseFloatOrZero(bi.points.at(before)), parseFloatOrZero(bi.points.at(t))
+		switch {
+		case !wasIn && isIn:
+			out = append(out, ScopeChange{Time: t, Key: bi.key, Type: ScopeChangeAdded, Points: pointsAfter})
+		case wasIn && !isIn:
+			out = append(out, ScopeChange{Time: t, Key: bi.key, Type: ScopeChangeRemoved, Points: -pointsBefore})
+		case wasIn && isIn && pointsBefore != pointsAfter:
+			out = append(out, ScopeChange{Time: t, Key: bi.key, Type: ScopeChangePoints, Points: pointsAfter - pointsBefore})
+		}
+	}
+	return out
+}
+
+// TimeSeriesSet returns the daily burndown as series of issue counts, story points and remaining
+// estimate hours. Burnup charts use the scope and done series; burndown charts the remaining series.
+func (bd *Burndown) TimeSeriesSet() *timeseries.TimeSeriesSet {
+	tss := timeseries.NewTimeSeriesSet("Burndown")
+	tss.Interval = timeutil.IntervalDay
+	for _, d := range bd.Days {
+		tss.AddInt64(BurndownSeriesScopeIssues, d.Date, int64(d.ScopeIssues))
+		tss.AddInt64(BurndownSeriesDoneIssues, d.Date, int64(d.DoneIssues))
+		tss.AddFloat64(BurndownSeriesScopePoints, d.Date, d.ScopePoints)
+		tss.AddFloat64(BurndownSeriesDonePoints, d.Date, d.DonePoints)
+		tss.AddFloat64(BurndownSeriesRemainingPoints, d.Date, d.RemainingPoints)
+		tss.AddFloat64(BurndownSeriesRemainingEstHrs, d.Date, d.RemainingEstimate.Hours())
+	}
+	tss.Order = []string{BurndownSeriesScopeIssues, BurndownSeriesDoneIssues, BurndownSeriesScopePoints,
+		BurndownSeriesDonePoints, BurndownSeriesRemainingPoints, BurndownSeriesRemainingEstHrs}
+	tss.Times = tss.TimeSlice(true)
+	return &tss
+}
+
+// Table returns the daily burndown with one row per day.
+func (bd *Burndown) Table() *table.Table {
+	tbl := table.NewTable("burndown")
+	tbl.Columns = []string{"Date", BurndownSeriesScopeIssues, BurndownSeriesDoneIssues, BurndownSeriesScopePoints,
+		BurndownSeriesDonePoints, BurndownSeriesRemainingPoints, BurndownSeriesRemainingEstHrs}
+	tbl.FormatMap = map[int]string{0: table.FormatDate, 1: table.FormatInt, 2: table.FormatInt,
+		3: table.FormatFloat, 4: table.FormatFloat, 5: table.FormatFloat, 6: table.FormatFloat}
+	for _, d := range bd.Days {
+		tbl.Rows = append(tbl.Rows, []string{
+			d.Date.Format(time.DateOnly),
+			strconv.Itoa(d.ScopeIssues),
+			strconv.Itoa(d.DoneIssues),
+			strconv.FormatFloat(d.ScopePoints, 'f', -1, 64),
+			strconv.FormatFloat(d.DonePoints, 'f', -1, 64),
+			strconv.FormatFloat(d.RemainingPoints, 'f', -1, 64),
+			fmt.Sprintf("%.2f", d.RemainingEstimate.Hours())})
+	}
+	return &tbl
+}
+
+// ScopeChangesTable returns the scope changes with one row per change.
+func (bd *Burndown) ScopeChangesTable() *table.Table {
+	tbl := table.NewTable("scope changes")
+	tbl.Columns = []string{"Time", "Key", "Type", "Points"}
+	tbl.FormatMap = map[int]string{0: table.FormatTime, 3: table.FormatFloat}
+	for _, sc := range bd.ScopeChanges {
+		tbl.Rows = append(tbl.Rows, []string{sc.Time.Format(time.RFC3339), sc.Key, sc.Type, strconv.FormatFloat(sc.Points, 'f', -1, 64)})
+	}
+	return &tbl
+}
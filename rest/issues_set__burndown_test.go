@@ -0,0 +1,53 @@
+package rest
+
+import (
+	"testing"
+	"time"
+
+	jira "github.com/andygrunwald/go-jira"
+)
+
+func TestBurndown(t *testing.T) {
+	iss := statusHistoryTestIssue()
+	iss.Fields.Unknowns = map[string]any{"customfield_10016": 5.0}
+	iss.Changelog.Histories = append(iss.Changelog.Histories,
+		jira.ChangelogHistory{Created: "2026-01-02T09:00:00.000+0000", Items: []jira.ChangelogItems{
+			{Field: "Sprint", From: "", To: "7", ToString: "Sprint 7"}}},
+		jira.ChangelogHistory{Created: "2026-01-03T09:00:00.000+0000", Items: []jira.ChangelogItems{
+			{Field: "Story Points", FromString: "3", ToString: "5"},
+			{Field: "timeestimate", From: "28800", To: "14400"}}})
+	set := NewIssuesSet(nil)
+	if err := set.Add(*iss); err != nil {
+		t.Fatalf("IssuesSet.Add() error = %v", err)
+	}
+
+	bd, err := set.Burndown(BurndownOptions{
+		Start:           time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC),
+		End:             time.Date(2026, 1, 4, 23, 0, 0, 0, time.UTC),
+		SprintID:        7,
+		PointsField:     "customfield_10016",
+		PointsFieldName: "Story Points",
+		DoneStatuses:    []string{"Done"}})
+	if err != nil {
+		t.Fatalf("IssuesSet.Burndown() error = %v", err)
+	}
+	want := []BurndownDay{
+		{},
+		{ScopeIssues: 1, ScopePoints: 3, RemainingPoints: 3, RemainingEstimate: 8 * time.Hour},
+		{ScopeIssues: 1, ScopePoints: 5, RemainingPoints: 5, RemainingEstimate: 4 * time.Hour},
+		{ScopeIssues: 1, DoneIssues: 1, ScopePoints: 5, DonePoints: 5}}
+	if len(bd.Days) != len(want) {
+		t.Fatalf("IssuesSet.Burndown() days mismatch: want (%d), got (%d)", len(want), len(bd.Days))
+	}
+	for i, w := range want {
+		w.Date = bd.Start.AddDate(0, 0, i)
+		if bd.Days[i] != w {
+			t.Errorf("IssuesSet.Burndown() day (%d) mismatch: want (%v), got (%v)", i, w, bd.Days[i])
+		}
+	}
+	if len(bd.ScopeChanges) != 2 ||
+		bd.ScopeChanges[0].Type != ScopeChangeAdded || bd.ScopeChanges[0].Points != 3 ||
+		bd.ScopeChanges[1].Type != ScopeChangePoints || bd.ScopeChanges[1].Points != 2 {
+		t.Errorf("IssuesSet.Burndown() scope changes mismatch: want (added 3, points 2), got (%v)", bd.ScopeChanges)
+	}
+}
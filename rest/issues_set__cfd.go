@@ -0,0 +1,180 @@
+package rest
+
+import (
+	"errors"
+	"fmt"
+	"sort"
+	"time"
+
+	"github.com/grokify/gocharts/v2/data/table"
+	"github.com/grokify/gocharts/v2/data/timeseries"
+	"github.com/grokify/mogo/pointer"
+	"github.com/grokify/mogo/time/timeutil"
+)
+
+// CFDOptions configures `IssuesSet.TimeSeriesSetCFD`.
+type CFDOptions struct {
+	Start        time.Time         // first day in UTC; zero uses the earliest issue creation time
+	End          time.Time         // last day; zero uses now
+	Interval     timeutil.Interval // `timeutil.IntervalDay` (default) or `timeutil.IntervalWeek`
+	UseMetaStage bool              // count meta stages using `StatusCategoryConfig.MetaStage`; unmapped statuses are kept
+}
+
+// TimeSeriesSetCFD returns cumulative flow diagram data: for each day or week from `Start` to
+// `End`, the number of issues in each status or meta stage at the end of that interval. Items are
+// keyed by the interval start. Issue status history is read from changelogs; issues without a
+// changelog are counted in their current status from creation. See `IssueService.IssuesSetLoadChangelogs`.
+// The series `Order` follows the workflow: meta stage order if a `StatusCategoryConfig` is set,
+// then any other statuses alphabetically.
+func (set *IssuesSet) TimeSeriesSetCFD(opts *CFDOptions) (*timeseries.TimeSeriesSet, error) {
+	if opts == nil {
+		opts = &CFDOptions{}
+	}
+	metaStage := func(s string) string { return s }
+	if opts.UseMetaStage {
+		if set.Config == nil || set.Config.StatusConfig == nil {
+			return nil, errors.New("status config not set")
+		}
+		metaStage = func(s string) string {
+			if ms := set.Config.StatusConfig.MetaStage(s); ms != "" {
+				return ms
+			}
+			return s
+		}
+	}
+
+	var histories []StatusHistory
+	for _, key := range set.Keys() {
+		sh, err := NewStatusHistory(pointer.Pointer(set.Items[key]))
+		if err != nil {
+			return nil, err
+		}
+		histories = append(histories, sh)
+	}
+
+	end := opts.End
+	if end.IsZero() {
+		end = time.Now()
+	}
+	start := opts.Start
+	if start.IsZero() {
+		for _, sh := range histories {
+			if !sh.Created.IsZero() && (start.IsZero() || sh.Created.Before(start)) {
+				start = sh.Created
+			}
+		}
+		if start.IsZero() {
+			start = end
+		}
+	}
+	start = start.UTC()
+	start = time.Date(start.Year(), start.Month(), start.Day(), 0, 0, 0, 0, start.Location())
+	step := func(t time.Time) time.Time { return t.AddDate(0, 0, 1) }
+	interval := timeutil.IntervalDay
+	if opts.Interval == timeutil.IntervalWeek {
+		step = func(t time.Time) time.Time { return t.AddDate(0, 0, 7) }
+		interval = timeutil.IntervalWeek
+	} else if opts.Interval != timeutil.IntervalDay && opts.Interval != timeutil.IntervalNanosecond {
+		return nil, fmt.Errorf("cfd interval not supported (%s)", opts.Interval.String())
+	}
+	if end.Before(start) {
+		return nil, errors.New("cfd end is before start")
+	}
+
+	tss := timeseries.NewTimeSeriesSet("Cumulative Flow")
+	tss.Interval = interval
+	periods := make([][]StatusPeriod, len(histories))
+	for i, sh := range histories {
+		periods[i] = sh.Periods(end)
+	}
+	seen := map[string]bool{}
+	var buckets []time.Time
+	var bucketCounts []map[string]int64
+	for t := start; !t.After(end); t = step(t) {
+		sample := step(t).Add(-time.Nanosecond)
+		if sample.After(end) {
+			sample = end
+		}
+		counts := map[string]int64{}
+		for i, sh := range histories {
+			if sh.Created.After(sample) {
+				continue
+			}
+			if status, ok := statusAt(periods[i], sample); ok {
+				name := metaStage(status)
+				counts[name]++
+				seen[name] = true
+			}
+		}
+		buckets = append(buckets, t)
+		bucketCounts = append(bucketCounts, counts)
+	}
+	for name := range seen {
+		for i, t := range buckets {
+			tss.AddInt64(name, t, bucketCounts[i][name])
+		}
+	}
+	tss.Order = set.cfdOrder(seen, opts.UseMetaStage)
+	tss.Times = tss.TimeSlice(true)
+	return &tss, nil
+}
+
+// statusAt returns the status of the period containing t.
+func statusAt(periods []StatusPeriod, t time.Time) (string, bool) {
+	for _, p := range periods {
+		if !t.Before(p.Start) && (p.Current || t.Before(p.End)) {
+			return p.Status, true
+		}
+	}
+	if len(periods) > 0 && t.Before(periods[0].Start) {
+		return periods[0].Status, true
+	}
+	return "", false
+}
+
+func (set *IssuesSet) cfdOrder(seen map[string]bool, useMetaStage bool) []string {
+	stageIndex := map[string]int{}
+	if set.Config != nil && set.Config.StatusConfig != nil {
+		stageIndex = set.Config.StatusConfig.StageConfig.Map()
+	}
+	index := func(name string) (int, bool) {
+		if !useMetaStage && set.Config != nil && set.Config.StatusConfig != nil {
+			name = set.Config.StatusConfig.MetaStage(name)
+		}
+		i, ok := stageIndex[name]
+		return i, ok
+	}
+	var order []string
+	for name := range seen {
+		order = append(order, name)
+	}
+	sort.Slice(order, func(i, j int) bool {
+		ii, iok := index(order[i])
+		ij, jok := index(order[j])
+		if iok != jok {
+			return iok
+		} else if iok && ii != ij {
+			return ii < ij
+		}
+		return order[i] < order[j]
+	})
+	return order
+}
+
+// CFDTable returns a table with a date column and one column per series in `Order`.
+func CFDTable(tss *timeseries.TimeSeriesSet) *table.Table {
+	tbl := table.NewTable(tss.Name)
+	tbl.Columns = append([]string{"Date"}, tss.Order...)
+	tbl.FormatMap = map[int]string{0: table.FormatDate}
+	for i := range tss.Order {
+		tbl.FormatMap[i+1] = table.FormatInt
+	}
+	for _, dt := range tss.TimeSlice(true) {
+		row := []string{dt.Format(time.DateOnly)}
+		for _, name := range tss.Order {
+			row = append(row, fmt.Sprintf("%d", tss.GetInt64WithDefault(name, dt.Format(time.RFC3339), 0)))
+		}
+		tbl.Rows = append(tbl.Rows, row)
+	}
+	return &tbl
+}
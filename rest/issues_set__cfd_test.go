@@ -0,0 +1,60 @@
+package rest
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/grokify/mogo/time/timeutil"
+
+	"github.com/grokify/gojira"
+)
+
+var timeSeriesSetCFDTests = []struct {
+	metaStage bool
+	order     []string
+	rows      []string
+}{
+	{false, []string{"To Do", "In Progress", "Done"}, []string{
+		"2025-12-31,0,0,0", "2026-01-01,1,0,0", "2026-01-02,0,1,0", "2026-01-03,0,1,0", "2026-01-04,0,0,1"}},
+	{true, []string{"Ready for Development", "In Development", "Done"}, []string{
+		"2025-12-31,0,0,0", "2026-01-01,1,0,0", "2026-01-02,0,1,0", "2026-01-03,0,1,0", "2026-01-04,0,0,1"}},
+}
+
+func TestTimeSeriesSetCFD(t *testing.T) {
+	for _, tt := range timeSeriesSetCFDTests {
+		set := NewIssuesSet(nil)
+		if err := set.Add(*statusHistoryTestIssue()); err != nil {
+			t.Fatalf("IssuesSet.Add() error = %v", err)
+		}
+		stages := gojira.DefaultStageSet()
+		scc := gojira.NewStatusConfig(*stages)
+		if err := scc.AddMapSlice(map[string][]string{
+			stages.ReadyForDevelopmentName(): {"To Do"},
+			stages.InDevelopmentName():       {"In Progress"},
+			stages.DoneName():                {"Done"}}); err != nil {
+			t.Fatalf("StatusCategoryConfig.AddMapSlice() error = %v", err)
+		}
+		set.Config.StatusConfig = &scc
+		tss, err := set.TimeSeriesSetCFD(&CFDOptions{
+			Start:        time.Date(2025, 12, 31, 0, 0, 0, 0, time.UTC),
+			End:          time.Date(2026, 1, 4, 23, 0, 0, 0, time.UTC),
+			Interval:     timeutil.IntervalDay,
+			UseMetaStage: tt.metaStage})
+		if err != nil {
+			t.Fatalf("IssuesSet.TimeSeriesSetCFD() error = %v", err)
+		}
+		if got := strings.Join(tss.Order, ","); got != strings.Join(tt.order, ",") {
+			t.Errorf("IssuesSet.TimeSeriesSetCFD() order mismatch: want (%v), got (%v)", tt.order, tss.Order)
+		}
+		tbl := CFDTable(tss)
+		if len(tbl.Rows) != len(tt.rows) {
+			t.Fatalf("CFDTable() rows mismatch: want (%d), got (%d)", len(tt.rows), len(tbl.Rows))
+		}
+		for i, row := range tbl.Rows {
+			if got := strings.Join(row, ","); got != tt.rows[i] {
+				t.Errorf("CFDTable() row (%d) mismatch: want (%s), got (%s)", i, tt.rows[i], got)
+			}
+		}
+	}
+}
@@ -0,0 +1,299 @@
+package rest
+
+import (
+	"fmt"
+	"math"
+	"slices"
+	"sort"
+	"strings"
+	"time"
+
+	jira "github.com/andygrunwald/go-jira"
+	"github.com/grokify/gocharts/v2/data/table"
+	"github.com/grokify/mogo/pointer"
+)
+
+const (
+	FlowMetricCycleTime = "cycle-time"
+	FlowMetricLeadTime  = "lead-time"
+
+	FlowGroupAll = "All"
+)
+
+// FlowTimesOptions sets the statuses that start and finish work. If either is empty, it is
+// read from the `StatusCategoryConfig` of the `IssuesSet` config: `StatusesInProgress()` and
+// `StatusesDone()`. Without start and done statuses, no cycle times are computed; lead times only
+// need the resolution date.
+type FlowTimesOptions struct {
+	StartStatuses []string
+	DoneStatuses  []string
+}
+
+// IssueFlowTime is the cycle time and lead time of a single issue. Cycle time runs from the
+// first entry into an in-progress status to the entry into a done status. Lead time runs from
+// creation to resolution, using the done time if the issue has no resolution date.
+type IssueFlowTime struct {
+	Key          string        `json:"key"`
+	Type         string        `json:"type"`
+	ProjectKey   string        `json:"projectKey"`
+	Status       string        `json:"status"`
+	Created      time.Time     `json:"created"`
+	Started      time.Time     `json:"started,omitzero"`
+	Done         time.Time     `json:"done,omitzero"`
+	Resolved     time.Time     `json:"resolved,omitzero"`
+	CycleTime    time.Duration `json:"cycleTime,omitempty"`
+	LeadTime     time.Duration `json:"leadTime,omitempty"`
+	HasCycleTime bool          `json:"hasCycleTime"`
+	HasLeadTime  bool          `json:"hasLeadTime"`
+}
+
+// Duration returns the duration for a `FlowMetricCycleTime` or `FlowMetricLeadTime` metric.
+func (ft IssueFlowTime) Duration(metric string) (time.Duration, bool, error) {
+	switch metric {
+	case FlowMetricCycleTime:
+		return ft.CycleTime, ft.HasCycleTime, nil
+	case FlowMetricLeadTime:
+		return ft.LeadTime, ft.HasLeadTime, nil
+	default:
+		return 0, false, fmt.Errorf("unknown flow metric (%s)", metric)
+	}
+}
+
+// NewIssueFlowTime computes the flow times for an issue. The cycle time requires the changelog.
+// An issue is done only if its current status is a done status. Work is considered done at its
+// last move from a status that is not done into a done status, so reopened issues are measured
+// to their final completion.
+func NewIssueFlowTime(iss *jira.Issue, startStatuses, doneStatuses []string) (IssueFlowTime, error) {
+	im := NewIssueMore(iss)
+	ft := IssueFlowTime{
+		Key:        im.Key(),
+		Type:       im.Type(),
+		ProjectKey: im.ProjectKey(),
+		Status:     im.Status(),
+		Created:    im.CreateTime(),
+		Resolved:   im.ResolutionTime()}
+	sh, err := NewStatusHistory(iss)
+	if err != nil {
+		return ft, err
+	}
+	isStart := statusMatcher(startStatuses)
+	isDone := statusMatcher(doneStatuses)
+	if isStart(sh.InitialStatus) {
+		ft.Started = sh.Created
+	}
+	for _, tr := range sh.Transitions {
+		if ft.Started.IsZero() && isStart(tr.To) {
+			ft.Started = tr.Time
+		}
+		if isDone(tr.To) && !isDone(tr.From) {
+			ft.Done = tr.Time
+		} else if !isDone(tr.To) {
+			ft.Done = time.Time{}
+		}
+	}
+	if !isDone(ft.Status) {
+		ft.Done = time.Time{}
+	}
+	if !ft.Started.IsZero() && !ft.Done.IsZero() && !ft.Done.Before(ft.Started) {
+		ft.CycleTime = ft.Done.Sub(ft.Started)
+		ft.HasCycleTime = true
+	}
+	end := ft.Resolved
+	if end.IsZero() {
+		end = ft.Done
+	}
+	if !ft.Created.IsZero() && !end.IsZero() && !end.Before(ft.Created) {
+		ft.LeadTime = end.Sub(ft.Created)
+		ft.HasLeadTime = true
+	}
+	return ft, nil
+}
+
+func statusMatcher(statuses []string) func(string) bool {
+	m := map[string]bool{}
+	for _, s := range statuses {
+		if s = strings.ToLower(strings.TrimSpace(s)); s != "" {
+			m[s] = true
+		}
+	}
+	return func(s string) bool { return m[strings.ToLower(strings.TrimSpace(s))] }
+}
+
+// FlowTimes returns the flow times for each issue in the set, sorted by key. Cycle times
+// are only available for issues with a changelog; see `IssueService.IssuesSetLoadChangelogs`.
+func (set *IssuesSet) FlowTimes(opts *FlowTimesOptions) ([]IssueFlowTime, error) {
+	if opts == nil {
+		opts = &FlowTimesOptions{}
+	}
+	startStatuses, doneStatuses := opts.StartStatuses, opts.DoneStatuses
+	if set.Config != nil && set.Config.StatusConfig != nil {
+		if len(startStatuses) == 0 {
+			startStatuses = set.Config.StatusConfig.StatusesInProgress()
+		}
+		if len(doneStatuses) == 0 {
+			doneStatuses = set.Config.StatusConfig.StatusesDone()
+		}
+	}
+	var out []IssueFlowTime
+	for _, key := range set.Keys() {
+		ft, err := NewIssueFlowTime(pointer.Pointer(set.Items[key]), startStatuses, doneStatuses)
+		if err != nil {
+			return nil, err
+		}
+		out = append(out, ft)
+	}
+	return out, nil
+}
+
+// DurationStats summarizes a set of durations. Percentiles use the nearest-rank method.
+type DurationStats struct {
+	Count int           `json:"count"`
+	Mean  time.Duration `json:"mean"`
+	Min   time.Duration `json:"min"`
+	Max   time.Duration `json:"max"`
+	P50   time.Duration `json:"p50"`
+	P85   time.Duration `json:"p85"`
+	P95   time.Duration `json:"p95"`
+}
+
+func NewDurationStats(durs []time.Duration) DurationStats {
+	if len(durs) == 0 {
+		return DurationStats{}
+	}
+	sorted := slices.Clone(durs)
+	slices.Sort(sorted)
+	var sum time.Duration
+	for _, d := range sorted {
+		sum += d
+	}
+	return DurationStats{
+		Count: len(sorted),
+		Mean:  sum / time.Duration(len(sorted)),
+		Min:   sorted[0],
+		Max:   sorted[len(sorted)-1],
+		P50:   DurationPercentile(sorted, 50),
+		P85:   DurationPercentile(sorted, 85),
+		P95:   DurationPercentile(sorted, 95)}
+}
+
+// DurationPercentile returns the nearest-rank percentile `p` (0-100) of durations sorted ascending.
+func DurationPercentile(sorted []time.Duration, p float64) time.Duration {
+	if len(sorted) == 0 {
+		return 0
+	}
+	rank := int(math.Ceil(p / 100 * float64(len(sorted))))
+	return sorted[min(max(rank-1, 0), len(sorted)-1)]
+}
+
+// FlowTimeGroupFunc returns the group name for an issue's flow time.
+type FlowTimeGroupFunc func(ft IssueFlowTime) (string, error)
+
+// FlowTimeGroupFuncBySlug returns a group func for `FieldSlugType` or `FieldSlugProjectkey`.
+// An empty slug groups all issues under `FlowGroupAll`.
+func FlowTimeGroupFuncBySlug(slug string) (FlowTimeGroupFunc, error) {
+	switch strings.ToLower(strings.TrimSpace(slug)) {
+	case "", "none", "all":
+		return nil, nil
+	case FieldSlugType:
+		return func(ft IssueFlowTime) (string, error) { return ft.Type, nil }, nil
+	case FieldSlugProjectkey, "project":
+		return func(ft IssueFlowTime) (string, error) { return ft.ProjectKey, nil }, nil
+	default:
+		return nil, fmt.Errorf("unknown flow time group (%s)", slug)
+	}
+}
+
+// FlowTimeGroupFuncByWorkstream returns a group func using a `WorkstreamFuncMake`.
+func FlowTimeGroupFuncByWorkstream(wsFuncMake WorkstreamFuncMake) FlowTimeGroupFunc {
+	return func(ft IssueFlowTime) (string, error) { return wsFuncMake(ft.Key) }
+}
+
+// FlowTimesStats returns duration statistics for a metric by group. A nil groupFunc puts all
+// issues in the `FlowGroupAll` group. Issues without the metric are skipped.
+func FlowTimesStats(fts []IssueFlowTime, metric string, groupFunc FlowTimeGroupFunc) (map[string]DurationStats, error) {
+	groups := map[string][]time.Duration{}
+	for _, ft := range fts {
+		d, ok, err := ft.Duration(metric)
+		if err != nil {
+			return nil, err
+		} else if !ok {
+			continue
+		}
+		group := FlowGroupAll
+		if groupFunc != nil {
+			if group, err = groupFunc(ft); err != nil {
+				return nil, err
+			}
+		}
+		groups[group] = append(groups[group], d)
+	}
+	out := map[string]DurationStats{}
+	for group, durs := range groups {
+		out[group] = NewDurationStats(durs)
+	}
+	return out, nil
+}
+
+// FlowTimesStatsTable returns a table of duration statistics in days, one row per group sorted by name.
+func FlowTimesStatsTable(stats map[string]DurationStats, groupColName string) *table.Table {
+	tbl := table.NewTable("flow stats")
+	tbl.Columns = []string{groupColName, "Count", "Mean Days", "P50 Days", "P85 Days", "P95 Days", "Min Days", "Max Days"}
+	tbl.FormatMap = map[int]string{1: table.FormatInt}
+	for i := 2; i < len(tbl.Columns); i++ {
+		tbl.FormatMap[i] = table.FormatFloat
+	}
+	groups := make([]string, 0, len(stats))
+	for group := range stats {
+		groups = append(groups, group)
+	}
+	sort.Strings(groups)
+	for _, group := range groups {
+		s := stats[group]
+		tbl.Rows = append(tbl.Rows, []string{
+			group,
+			fmt.Sprintf("%d", s.Count),
+			formatDays(s.Mean),
+			formatDays(s.P50),
+			formatDays(s.P85),
+			formatDays(s.P95),
+			formatDays(s.Min),
+			formatDays(s.Max)})
+	}
+	return &tbl
+}
+
+// FlowTimesTable returns a table of per-issue flow times in days.
+func FlowTimesTable(fts []IssueFlowTime) *table.Table {
+	tbl := table.NewTable("flow times")
+	tbl.Columns = []string{"Key", "Type", "Project Key", "Status", "Created", "Started", "Done", "Resolved", "Cycle Time Days", "Lead Time Days"}
+	// day columns are left unformatted so issues without a time stay blank instead of `0`.
+	tbl.FormatMap = map[int]string{4: table.FormatTime, 5: table.FormatTime, 6: table.FormatTime, 7: table.FormatTime}
+	for _, ft := range fts {
+		row := []string{ft.Key, ft.Type, ft.ProjectKey, ft.Status,
+			formatTimeOrEmpty(ft.Created), formatTimeOrEmpty(ft.Started), formatTimeOrEmpty(ft.Done), formatTimeOrEmpty(ft.Resolved), "", ""}
+		if ft.HasCycleTime {
+			row[8] = formatDays(ft.CycleTime)
+		}
+		if ft.HasLeadTime {
+			row[9] = formatDays(ft.LeadTime)
+		}
+		tbl.Rows = append(tbl.Rows, row)
+	}
+	return &tbl
+}
+
+// DurationDays returns a duration in calendar days.
+func DurationDays(d time.Duration) float64 {
+	return d.Hours() / 24
+}
+
+func formatDays(d time.Duration) string {
+	return fmt.Sprintf("%.2f", DurationDays(d))
+}
+
+func formatTimeOrEmpty(t time.Time) string {
+	if t.IsZero() {
+		return ""
+	}
+	return t.Format(time.RFC3339)
+}
@@ -0,0 +1,49 @@
+package rest
+
+import (
+	"testing"
+	"time"
+
+	jira "github.com/andygrunwald/go-jira"
+)
+
+func TestNewIssueFlowTime(t *testing.T) {
+	iss := statusHistoryTestIssue()
+	iss.Fields.Resolutiondate = jira.Time(time.Date(2026, 1, 6, 9, 0, 0, 0, time.UTC))
+	day := 24 * time.Hour
+
+	ft, err := NewIssueFlowTime(iss, []string{"In Progress"}, []string{"Done"})
+	if err != nil {
+		t.Fatalf("NewIssueFlowTime() error = %v", err)
+	}
+	if !ft.HasCycleTime || ft.CycleTime != 2*day {
+		t.Errorf("NewIssueFlowTime() cycle time mismatch: want (%v), got (%v, %v)", 2*day, ft.HasCycleTime, ft.CycleTime)
+	}
+	if !ft.HasLeadTime || ft.LeadTime != 5*day {
+		t.Errorf("NewIssueFlowTime() lead time mismatch: want (%v), got (%v, %v)", 5*day, ft.HasLeadTime, ft.LeadTime)
+	}
+
+	// reopened and not done again
+	iss.Fields.Status.Name = "In Progress"
+	iss.Changelog.Histories = append(iss.Changelog.Histories, jira.ChangelogHistory{
+		Created: "2026-01-07T09:00:00.000+0000",
+		Items:   []jira.ChangelogItems{{Field: "status", FromString: "Done", ToString: "In Progress"}}})
+	if ft, err = NewIssueFlowTime(iss, []string{"In Progress"}, []string{"Done"}); err != nil {
+		t.Fatalf("NewIssueFlowTime() error = %v", err)
+	} else if ft.HasCycleTime {
+		t.Errorf("NewIssueFlowTime() reopened cycle time mismatch: want (none), got (%v)", ft.CycleTime)
+	}
+}
+
+func TestNewDurationStats(t *testing.T) {
+	var durs []time.Duration
+	for i := 20; i >= 1; i-- {
+		durs = append(durs, time.Duration(i)*time.Hour)
+	}
+	s := NewDurationStats(durs)
+	want := DurationStats{Count: 20, Mean: 630 * time.Minute, Min: time.Hour, Max: 20 * time.Hour,
+		P50: 10 * time.Hour, P85: 17 * time.Hour, P95: 19 * time.Hour}
+	if s != want {
+		t.Errorf("NewDurationStats() mismatch: want (%+v), got (%+v)", want, s)
+	}
+}
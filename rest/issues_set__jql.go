@@ -0,0 +1,848 @@
+package rest
+
+import (
+	"cmp"
+	"fmt"
+	"regexp"
+	"slices"
+	"strconv"
+	"strings"
+	"time"
+	"unicode"
+
+	jira "github.com/andygrunwald/go-jira"
+
+	"github.com/grokify/gojira"
+)
+
+// JQLEvalOptions configures local JQL evaluation with `IssuesSet.FilterJQL`.
+type JQLEvalOptions struct {
+	CustomFieldSet *CustomFieldSet // resolves custom field names; `cf[10010]` and `customfield_10010` work without it
+	CurrentUser    string          // account ID, name, email or display name matched by `currentUser()`
+	Now            time.Time       // for relative dates and date functions; zero uses `time.Now()`
+	Location       *time.Location  // for dates without a time zone and date functions; nil uses `time.Local`
+	WeekStart      time.Weekday    // first day for `startOfWeek()` and `endOfWeek()`; the zero value is Sunday, as in Jira
+}
+
+// JQLMatchFunc reports if an issue matches a query.
+type JQLMatchFunc func(iss *jira.Issue) bool
+
+// JQLUnsupportedClause is a clause that cannot be evaluated locally.
+type JQLUnsupportedClause struct {
+	Clause string
+	Pos    gojira.JQLPos
+	Reason string
+}
+
+// JQLUnsupportedError lists the clauses of a query that cannot be evaluated locally.
+type JQLUnsupportedError struct {
+	Clauses []JQLUnsupportedClause
+}
+
+func (e *JQLUnsupportedError) Error() string {
+	var parts []string
+	for _, c := range e.Clauses {
+		parts = append(parts, fmt.Sprintf("`%s` (column %d): %s", c.Clause, c.Pos.Column, c.Reason))
+	}
+	return "jql cannot be evaluated locally: " + strings.Join(parts, "; ")
+}
+
+// FilterJQL returns the issues in the set that match a JQL query, evaluated locally. `ORDER BY` is
+// ignored. As in Jira, `!=`, `NOT IN` and `!~` do not match issues where the field is empty. If any
+// clause is not supported, a `*JQLUnsupportedError` listing each one is returned; see `CompileJQL`.
+func (set *IssuesSet) FilterJQL(jql string, opts *JQLEvalOptions) (*IssuesSet, error) {
+	q, err := gojira.ParseJQL(jql)
+	if err != nil {
+		return nil, err
+	}
+	match, err := CompileJQL(q, opts)
+	if err != nil {
+		return nil, err
+	}
+	out := NewIssuesSet(set.Config)
+	out.Parents = set.Parents
+	for key, iss := range set.Items {
+		if match(&iss) {
+			out.Items[key] = iss
+		}
+	}
+	return out, nil
+}
+
+// KeysOrderJQL returns the keys of the issues in the set sorted by a JQL `ORDER BY` list, such as
+// `JQLQuery.OrderBy`. Dates are compared as times, keys by project key then issue number, and other
+// fields by their first value, as numbers if both are numeric. Empty values sort after other
+// values, as in Jira, and the direction defaults to `ASC`. Ties, and all issues if orderBy is empty, are sorted by key.
+func (set *IssuesSet) KeysOrderJQL(orderBy []gojira.JQLOrderBy, opts *JQLEvalOptions) ([]string, error) {
+	c := newJQLCompiler(opts)
+	var cmps []func(a, b *jira.Issue) int
+	for _, o := range orderBy {
+		fn, err := c.orderBy(o.Field.Name)
+		if err != nil {
+			return nil, err
+		}
+		if strings.EqualFold(o.Direction, "DESC") {
+			asc := fn
+			fn = func(a, b *jira.Issue) int { return asc(b, a) }
+		}
+		cmps = append(cmps, fn)
+	}
+	keys := set.Keys()
+	slices.SortFunc(keys, func(ka, kb string) int {
+		a, b := set.Items[ka], set.Items[kb]
+		for _, fn := range cmps {
+			if r := fn(&a, &b); r != 0 {
+				return r
+			}
+		}
+		return jqlKeyCompare(ka, kb)
+	})
+	return keys, nil
+}
+
+// orderBy returns an ascending comparison for a field in an `ORDER BY` list.
+func (c *jqlCompiler) orderBy(name string) (func(a, b *jira.Issue) int, error) {
+	switch strings.ToLower(strings.TrimSpace(name)) {
+	case gojira.FieldKey, gojira.AliasIssueKey, gojira.FieldIssue:
+		return func(a, b *jira.Issue) int { return jqlKeyCompare(a.Key, b.Key) }, nil
+	}
+	f, err := c.field(name)
+	if err != nil {
+		return nil, err
+	}
+	if f.kind == jqlFieldDate {
+		return func(a, b *jira.Issue) int {
+			ta, tb := f.time(a), f.time(b)
+			if ta.IsZero() || tb.IsZero() {
+				return jqlEmptyCompare(ta.IsZero(), tb.IsZero())
+			}
+			return ta.Compare(tb)
+		}, nil
+	}
+	return func(a, b *jira.Issue) int {
+		va, vb := f.values(a), f.values(b)
+		if len(va) == 0 || len(vb) == 0 {
+			return jqlEmptyCompare(len(va) == 0, len(vb) == 0)
+		}
+		fa, errA := strconv.ParseFloat(va[0], 64)
+		fb, errB := strconv.ParseFloat(vb[0], 64)
+		if errA == nil && errB == nil {
+			return cmp.Compare(fa, fb)
+		}
+		return strings.Compare(strings.ToLower(va[0]), strings.ToLower(vb[0]))
+	}, nil
+}
+
+// jqlEmptyCompare orders empty values after non-empty ones.
+func jqlEmptyCompare(aEmpty, bEmpty bool) int {
+	switch {
+	case aEmpty == bEmpty:
+		return 0
+	case aEmpty:
+		return 1
+	default:
+		return -1
+	}
+}
+
+// jqlKeyCompare compares issue keys by project key, then issue number, so `FOO-2` sorts before
+// `FOO-10`.
+func jqlKeyCompare(a, b string) int {
+	projA, numA, okA := jqlSplitKey(a)
+	projB, numB, okB := jqlSplitKey(b)
+	if !okA || !okB {
+		return strings.Compare(a, b)
+	} else if r := strings.Compare(projA, projB); r != 0 {
+		return r
+	}
+	return numA - numB
+}
+
+func jqlSplitKey(key string) (string, int, bool) {
+	i := strings.LastIndex(key, "-")
+	if i < 0 {
+		return "", 0, false
+	}
+	n, err := strconv.Atoi(key[i+1:])
+	return key[:i], n, err == nil
+}
+
+// CompileJQL returns a function that evaluates a parsed query against an issue. Supported fields
+// are project, issuetype, status, statusCategory, priority, resolution, labels, component,
+// fixVersion, affectedVersion, assignee, reporter, creator, key, parent, summary, description,
+// environment, text, created, updated, resolved, due and custom fields. `WAS` and `CHANGED`, and
+// functions other than `currentUser()` and date functions such as `startOfWeek()`, are not supported.
+func CompileJQL(q *gojira.JQLQuery, opts *JQLEvalOptions) (JQLMatchFunc, error) {
+	c := newJQLCompiler(opts)
+	if q == nil || q.Where == nil {
+		return func(*jira.Issue) bool { return true }, nil
+	}
+	match := c.expr(q.Where)
+	if len(c.unsupported) > 0 {
+		return nil, &JQLUnsupportedError{Clauses: c.unsupported}
+	}
+	return match, nil
+}
+
+type jqlCompiler struct {
+	opts        JQLEvalOptions
+	unsupported []JQLUnsupportedClause
+}
+
+func newJQLCompiler(opts *JQLEvalOptions) *jqlCompiler {
+	c := &jqlCompiler{}
+	if opts != nil {
+		c.opts = *opts
+	}
+	if c.opts.Location == nil {
+		c.opts.Location = time.Local
+	}
+	if c.opts.Now.IsZero() {
+		c.opts.Now = time.Now()
+	}
+	c.opts.Now = c.opts.Now.In(c.opts.Location)
+	return c
+}
+
+func (c *jqlCompiler) expr(e gojira.JQLExpr) JQLMatchFunc {
+	switch x := e.(type) {
+	case *gojira.JQLAnd:
+		var fns []JQLMatchFunc
+		for _, sub := range x.Exprs {
+			fns = append(fns, c.expr(sub))
+		}
+		return func(iss *jira.Issue) bool {
+			for _, fn := range fns {
+				if !fn(iss) {
+					return false
+				}
+			}
+			return true
+		}
+	case *gojira.JQLOr:
+		var fns []JQLMatchFunc
+		for _, sub := range x.Exprs {
+			fns = append(fns, c.expr(sub))
+		}
+		return func(iss *jira.Issue) bool {
+			for _, fn := range fns {
+				if fn(iss) {
+					return true
+				}
+			}
+			return false
+		}
+	case *gojira.JQLNot:
+		fn := c.expr(x.Expr)
+		return func(iss *jira.Issue) bool { return !fn(iss) }
+	case *gojira.JQLClause:
+		return c.clause(x)
+	default:
+		return func(*jira.Issue) bool { return false }
+	}
+}
+
+func (c *jqlCompiler) fail(cl *gojira.JQLClause, format string, a ...any) JQLMatchFunc {
+	c.unsupported = append(c.unsupported, JQLUnsupportedClause{
+		Clause: cl.String(),
+		Pos:    cl.Pos,
+		Reason: fmt.Sprintf(format, a...)})
+	return func(*jira.Issue) bool { return false }
+}
+
+type jqlFieldKind int
+
+const (
+	jqlFieldString jqlFieldKind = iota // matched by name or ID
+	jqlFieldText                       // matched with `~`
+	jqlFieldDate
+	jqlFieldAny // custom fields of unknown type: string, text or number
+)
+
+type jqlField struct {
+	kind       jqlFieldKind
+	values     func(iss *jira.Issue) []string
+	time       func(iss *jira.Issue) time.Time // date fields; zero if empty
+	emptyValue string                          // a value that means `EMPTY`, as `Unresolved` does for resolution
+}
+
+// jqlValueUnresolved matches issues without a resolution in `resolution = Unresolved`.
+const jqlValueUnresolved = "Unresolved"
+
+func (c *jqlCompiler) clause(cl *gojira.JQLClause) JQLMatchFunc {
+	switch cl.Operator {
+	case gojira.OperatorWas, gojira.OperatorWasNot, gojira.OperatorWasIn, gojira.OperatorWasNotIn, gojira.OperatorChanged:
+		return c.fail(cl, "%s needs issue history", cl.Operator)
+	}
+	f, err := c.field(cl.Field.Name)
+	if err != nil {
+		return c.fail(cl, "%s", err.Error())
+	}
+	if f.kind == jqlFieldDate {
+		return c.dateClause(cl, f)
+	}
+
+	switch cl.Operator {
+	case gojira.OperatorIs, gojira.OperatorIsNot:
+		not := cl.Operator == gojira.OperatorIsNot
+		return func(iss *jira.Issue) bool { return (len(f.values(iss)) == 0) != not }
+	case gojira.OperatorLike, gojira.OperatorNotLike:
+		if f.kind == jqlFieldString {
+			return c.fail(cl, "%s only supports text fields", cl.Operator)
+		}
+		lit, ok := cl.Value.(*gojira.JQLLiteral)
+		if !ok || lit.Empty {
+			return c.fail(cl, "%s needs a text value", cl.Operator)
+		}
+		not := cl.Operator == gojira.OperatorNotLike
+		return func(iss *jira.Issue) bool {
+			vals := f.values(iss)
+			if len(vals) == 0 {
+				return false
+			}
+			return jqlTextMatch(strings.Join(vals, "\n"), lit.Value) != not
+		}
+	case gojira.OperatorGT, gojira.OperatorGTE, gojira.OperatorLT, gojira.OperatorLTE:
+		if f.kind != jqlFieldAny {
+			return c.fail(cl, "%s only supports date and number fields", cl.Operator)
+		}
+		lit, ok := cl.Value.(*gojira.JQLLiteral)
+		if !ok || lit.Empty {
+			return c.fail(cl, "%s needs a number", cl.Operator)
+		}
+		want, err := strconv.ParseFloat(lit.Value, 64)
+		if err != nil {
+			return c.fail(cl, "%s needs a number", cl.Operator)
+		}
+		return func(iss *jira.Issue) bool {
+			for _, v := range f.values(iss) {
+				if got, err := strconv.ParseFloat(v, 64); err == nil && jqlCompare(cl.Operator, got, want) {
+					return true
+				}
+			}
+			return false
+		}
+	}
+
+	wants, wantEmpty, err := c.stringValues(cl.Value)
+	if err != nil {
+		return c.fail(cl, "%s", err.Error())
+	}
+	if f.emptyValue != "" {
+		wants = slices.DeleteFunc(wants, func(w string) bool {
+			if strings.EqualFold(w, f.emptyValue) {
+				wantEmpty = true
+				return true
+			}
+			return false
+		})
+	}
+	has := func(iss *jira.Issue) (bool, bool) {
+		vals := f.values(iss)
+		for _, v := range vals {
+			for _, w := range wants {
+				if strings.EqualFold(v, w) {
+					return true, len(vals) == 0
+				}
+			}
+		}
+		return false, len(vals) == 0
+	}
+	switch cl.Operator {
+	case gojira.OperatorEQ, gojira.OperatorIn:
+		return func(iss *jira.Issue) bool {
+			found, empty := has(iss)
+			return found || (empty && wantEmpty)
+		}
+	case gojira.OperatorNE, gojira.OperatorNotIn:
+		return func(iss *jira.Issue) bool {
+			found, empty := has(iss)
+			return !found && !empty
+		}
+	default:
+		return c.fail(cl, "operator %s is not supported", cl.Operator)
+	}
+}
+
+func (c *jqlCompiler) dateClause(cl *gojira.JQLClause, f jqlField) JQLMatchFunc {
+	switch cl.Operator {
+	case gojira.OperatorIs, gojira.OperatorIsNot:
+		not := cl.Operator == gojira.OperatorIsNot
+		return func(iss *jira.Issue) bool { return f.time(iss).IsZero() != not }
+	case gojira.OperatorLike, gojira.OperatorNotLike:
+		return c.fail(cl, "%s does not support date fields", cl.Operator)
+	}
+	var vals []gojira.JQLValue
+	if list, ok := cl.Value.(*gojira.JQLList); ok {
+		vals = list.Values
+	} else {
+		vals = []gojira.JQLValue{cl.Value}
+	}
+	var wants []time.Time
+	wantEmpty := false
+	for _, v := range vals {
+		if lit, ok := v.(*gojira.JQLLiteral); ok && lit.Empty {
+			wantEmpty = true
+			continue
+		}
+		t, err := c.dateValue(v)
+		if err != nil {
+			return c.fail(cl, "%s", err.Error())
+		}
+		wants = append(wants, t)
+	}
+	op := cl.Operator
+	return func(iss *jira.Issue) bool {
+		got := f.time(iss)
+		if got.IsZero() {
+			return wantEmpty && (op == gojira.OperatorEQ || op == gojira.OperatorIn)
+		}
+		switch op {
+		case gojira.OperatorEQ, gojira.OperatorIn:
+			return slices.ContainsFunc(wants, got.Equal)
+		case gojira.OperatorNE, gojira.OperatorNotIn:
+			return !slices.ContainsFunc(wants, got.Equal)
+		default:
+			for _, w := range wants {
+				if !jqlCompare(op, float64(got.UnixNano()), float64(w.UnixNano())) {
+					return false
+				}
+			}
+			return len(wants) > 0
+		}
+	}
+}
+
+// stringValues returns the values to match, and whether `EMPTY` is one of them.
+func (c *jqlCompiler) stringValues(v gojira.JQLValue) ([]string, bool, error) {
+	var vals []gojira.JQLValue
+	if list, ok := v.(*gojira.JQLList); ok {
+		vals = list.Values
+	} else {
+		vals = []gojira.JQLValue{v}
+	}
+	var out []string
+	wantEmpty := false
+	for _, x := range vals {
+		switch y := x.(type) {
+		case *gojira.JQLLiteral:
+			if y.Empty {
+				wantEmpty = true
+			} else {
+				out = append(out, y.Value)
+			}
+		case *gojira.JQLFunction:
+			if !strings.EqualFold(y.Name, "currentUser") {
+				return nil, false, fmt.Errorf("function %s() is not supported", y.Name)
+			} else if strings.TrimSpace(c.opts.CurrentUser) == "" {
+				return nil, false, fmt.Errorf("currentUser() needs a current user")
+			}
+			out = append(out, c.opts.CurrentUser)
+		default:
+			return nil, false, fmt.Errorf("value %s is not supported", x.String())
+		}
+	}
+	return out, wantEmpty, nil
+}
+
+var jqlDateLayouts = []string{"2006-01-02 15:04", "2006/01/02 15:04", time.DateOnly, "2006/01/02"}
+
+// dateValue returns the time for a date literal, relative date such as `-2w` or date function.
+func (c *jqlCompiler) dateValue(v gojira.JQLValue) (time.Time, error) {
+	now := c.opts.Now
+	switch x := v.(type) {
+	case *gojira.JQLLiteral:
+		s := strings.TrimSpace(x.Value)
+		for _, layout := range jqlDateLayouts {
+			if t, err := time.ParseInLocation(layout, s, c.opts.Location); err == nil {
+				return t, nil
+			}
+		}
+		if t, ok := jqlOffset(now, s, ""); ok {
+			return t, nil
+		}
+		return time.Time{}, fmt.Errorf("invalid date (%s)", s)
+	case *gojira.JQLFunction:
+		return c.dateFunction(x)
+	default:
+		return time.Time{}, fmt.Errorf("value %s is not a date", v.String())
+	}
+}
+
+func (c *jqlCompiler) dateFunction(fn *gojira.JQLFunction) (time.Time, error) {
+	name := strings.ToLower(fn.Name)
+	if name == "now" {
+		return c.opts.Now, nil
+	}
+	var unit string
+	switch strings.TrimPrefix(strings.TrimPrefix(name, "startof"), "endof") {
+	case "day":
+		unit = "d"
+	case "week":
+		unit = "w"
+	case "month":
+		unit = "M"
+	case "year":
+		unit = "y"
+	}
+	if unit == "" || (!strings.HasPrefix(name, "startof") && !strings.HasPrefix(name, "endof")) {
+		return time.Time{}, fmt.Errorf("function %s() is not supported", fn.Name)
+	} else if len(fn.Args) > 1 {
+		return time.Time{}, fmt.Errorf("function %s() takes at most one argument", fn.Name)
+	}
+	inc := ""
+	if len(fn.Args) == 1 {
+		inc = strings.TrimSpace(fn.Args[0].Value)
+	}
+	// An increment in the period's unit shifts the period; other units shift the result.
+	start := c.periodStart(c.opts.Now, unit)
+	var shift string
+	if inc != "" {
+		if n, err := strconv.Atoi(strings.TrimSuffix(inc, unit)); err == nil {
+			start = jqlAddUnits(start, n, unit)
+		} else {
+			shift = inc
+		}
+	}
+	t := start
+	if strings.HasPrefix(name, "endof") {
+		t = jqlAddUnits(start, 1, unit).Add(-time.Millisecond)
+	}
+	if shift != "" {
+		var ok bool
+		if t, ok = jqlOffset(t, shift, unit); !ok {
+			return time.Time{}, fmt.Errorf("invalid increment (%s) for %s()", shift, fn.Name)
+		}
+	}
+	return t, nil
+}
+
+func (c *jqlCompiler) periodStart(t time.Time, unit string) time.Time {
+	day := time.Date(t.Year(), t.Month(), t.Day(), 0, 0, 0, 0, t.Location())
+	switch unit {
+	case "w":
+		return day.AddDate(0, 0, -((int(day.Weekday()) - int(c.opts.WeekStart) + 7) % 7))
+	case "M":
+		return time.Date(t.Year(), t.Month(), 1, 0, 0, 0, 0, t.Location())
+	case "y":
+		return time.Date(t.Year(), 1, 1, 0, 0, 0, 0, t.Location())
+	default:
+		return day
+	}
+}
+
+var rxJQLOffset = regexp.MustCompile(`^([+-]?)((?:\d+[yMwdhm]?\s*)+)$`)
+var rxJQLOffsetPart = regexp.MustCompile(`(\d+)([yMwdhm]?)`)
+
+// jqlOffset adds a relative date such as `-2w`, `+1d` or `-1w 2d` to t. Numbers without a unit use
+// defaultUnit and are invalid if it is empty.
+func jqlOffset(t time.Time, s, defaultUnit string) (time.Time, bool) {
+	m := rxJQLOffset.FindStringSubmatch(strings.TrimSpace(s))
+	if m == nil {
+		return t, false
+	}
+	sign := 1
+	if m[1] == "-" {
+		sign = -1
+	}
+	for _, part := range rxJQLOffsetPart.FindAllStringSubmatch(m[2], -1) {
+		n, err := strconv.Atoi(part[1])
+		if err != nil {
+			return t, false
+		}
+		unit := part[2]
+		if unit == "" {
+			if unit = defaultUnit; unit == "" {
+				return t, false
+			}
+		}
+		t = jqlAddUnits(t, sign*n, unit)
+	}
+	return t, true
+}
+
+func jqlAddUnits(t time.Time, n int, unit string) time.Time {
+	switch unit {
+	case "y":
+		return t.AddDate(n, 0, 0)
+	case "M":
+		return t.AddDate(0, n, 0)
+	case "w":
+		return t.AddDate(0, 0, 7*n)
+	case "d":
+		return t.AddDate(0, 0, n)
+	case "h":
+		return t.Add(time.Duration(n) * time.Hour)
+	default:
+		return t.Add(time.Duration(n) * time.Minute)
+	}
+}
+
+func jqlCompare(op string, got, want float64) bool {
+	switch op {
+	case gojira.OperatorGT:
+		return got > want
+	case gojira.OperatorGTE:
+		return got >= want
+	case gojira.OperatorLT:
+		return got < want
+	default:
+		return got <= want
+	}
+}
+
+// jqlTextMatch reports if text contains every word of term, case-insensitive. A term in quotes
+// matches as a phrase, and a trailing `*` or `?` on a word is ignored.
+func jqlTextMatch(text, term string) bool {
+	text = strings.ToLower(text)
+	term = strings.ToLower(strings.TrimSpace(term))
+	if len(term) > 1 && strings.HasPrefix(term, `"`) && strings.HasSuffix(term, `"`) {
+		return strings.Contains(text, strings.Trim(term, `"`))
+	}
+	words := strings.FieldsFunc(term, func(r rune) bool {
+		return !unicode.IsLetter(r) && !unicode.IsNumber(r) && r != '*' && r != '?'
+	})
+	for _, w := range words {
+		if w = strings.TrimRight(w, "*?"); w != "" && !strings.Contains(text, w) {
+			return false
+		}
+	}
+	return len(words) > 0
+}
+
+// field returns the accessor for a system or custom field name, case-insensitive.
+func (c *jqlCompiler) field(name string) (jqlField, error) {
+	str := func(fn func(f *jira.IssueFields) []string) jqlField {
+		return jqlField{kind: jqlFieldString, values: func(iss *jira.Issue) []string {
+			if iss.Fields == nil {
+				return nil
+			}
+			return jqlCondense(fn(iss.Fields))
+		}}
+	}
+	text := func(fn func(f *jira.IssueFields) []string) jqlField {
+		f := str(fn)
+		f.kind = jqlFieldText
+		return f
+	}
+	date := func(fn func(f *jira.IssueFields) time.Time) jqlField {
+		return jqlField{kind: jqlFieldDate, time: func(iss *jira.Issue) time.Time {
+			if iss.Fields == nil {
+				return time.Time{}
+			}
+			return fn(iss.Fields)
+		}}
+	}
+
+	switch strings.ToLower(strings.TrimSpace(name)) {
+	case gojira.FieldProject:
+		return str(func(f *jira.IssueFields) []string { return []string{f.Project.Key, f.Project.Name, f.Project.ID} }), nil
+	case "issuetype", gojira.FieldType:
+		return str(func(f *jira.IssueFields) []string { return []string{f.Type.Name, f.Type.ID} }), nil
+	case gojira.FieldStatus:
+		return str(func(f *jira.IssueFields) []string {
+			if f.Status == nil {
+				return nil
+			}
+			return []string{f.Status.Name, f.Status.ID}
+		}), nil
+	case "statuscategory":
+		return str(func(f *jira.IssueFields) []string {
+			if f.Status == nil {
+				return nil
+			}
+			sc := f.Status.StatusCategory
+			out := []string{sc.Name, sc.Key}
+			if sc.ID != 0 {
+				out = append(out, strconv.Itoa(sc.ID))
+			}
+			return out
+		}), nil
+	case "priority":
+		return str(func(f *jira.IssueFields) []string {
+			if f.Priority == nil {
+				return nil
+			}
+			return []string{f.Priority.Name, f.Priority.ID}
+		}), nil
+	case gojira.FieldResolution:
+		f := str(func(f *jira.IssueFields) []string {
+			if f.Resolution == nil {
+				return nil
+			}
+			return []string{f.Resolution.Name, f.Resolution.ID}
+		})
+		f.emptyValue = jqlValueUnresolved
+		return f, nil
+	case gojira.FieldLabels:
+		return str(func(f *jira.IssueFields) []string { return f.Labels }), nil
+	case "component":
+		return str(func(f *jira.IssueFields) []string {
+			var out []string
+			for _, x := range f.Components {
+				if x != nil {
+					out = append(out, x.Name)
+				}
+			}
+			return out
+		}), nil
+	case "fixversion":
+		return str(func(f *jira.IssueFields) []string {
+			var out []string
+			for _, x := range f.FixVersions {
+				if x != nil {
+					out = append(out, x.Name)
+				}
+			}
+			return out
+		}), nil
+	case "affectedversion":
+		return str(func(f *jira.IssueFields) []string {
+			var out []string
+			for _, x := range f.AffectsVersions {
+				if x != nil {
+					out = append(out, x.Name)
+				}
+			}
+			return out
+		}), nil
+	case "assignee":
+		return str(func(f *jira.IssueFields) []string { return jqlUserValues(f.Assignee) }), nil
+	case "reporter":
+		return str(func(f *jira.IssueFields) []string { return jqlUserValues(f.Reporter) }), nil
+	case "creator":
+		return str(func(f *jira.IssueFields) []string { return jqlUserValues(f.Creator) }), nil
+	case gojira.FieldKey, gojira.AliasIssueKey, gojira.FieldIssue, "id":
+		return jqlField{kind: jqlFieldString, values: func(iss *jira.Issue) []string {
+			return jqlCondense([]string{iss.Key, iss.ID})
+		}}, nil
+	case gojira.FieldParent:
+		return str(func(f *jira.IssueFields) []string {
+			if f.Parent == nil {
+				return nil
+			}
+			return []string{f.Parent.Key, f.Parent.ID}
+		}), nil
+	case gojira.FieldSummary:
+		return text(func(f *jira.IssueFields) []string { return []string{f.Summary} }), nil
+	case "description":
+		return text(func(f *jira.IssueFields) []string { return []string{f.Description} }), nil
+	case "environment":
+		return text(func(f *jira.IssueFields) []string { return []string{f.Environment} }), nil
+	case gojira.FieldText:
+		return text(func(f *jira.IssueFields) []string {
+			out := []string{f.Summary, f.Description, f.Environment}
+			if f.Comments != nil {
+				for _, cm := range f.Comments.Comments {
+					if cm != nil {
+						out = append(out, cm.Body)
+					}
+				}
+			}
+			return out
+		}), nil
+	case "created", gojira.FieldCreatedDate:
+		return date(func(f *jira.IssueFields) time.Time { return time.Time(f.Created) }), nil
+	case gojira.FieldUpdated, "updateddate":
+		return date(func(f *jira.IssueFields) time.Time { return time.Time(f.Updated) }), nil
+	case "resolved", "resolutiondate":
+		return date(func(f *jira.IssueFields) time.Time { return time.Time(f.Resolutiondate) }), nil
+	case "due", gojira.FieldDueDate:
+		return date(func(f *jira.IssueFields) time.Time {
+			if t := time.Time(f.Duedate); !t.IsZero() {
+				return time.Date(t.Year(), t.Month(), t.Day(), 0, 0, 0, 0, c.opts.Location)
+			}
+			return time.Time{}
+		}), nil
+	}
+	return c.customField(name)
+}
+
+func (c *jqlCompiler) customField(name string) (jqlField, error) {
+	id, ok := gojira.IsCustomFieldKey(name)
+	schemaType := ""
+	if c.opts.CustomFieldSet != nil && len(c.opts.CustomFieldSet.Data) > 0 {
+		cf, err := c.opts.CustomFieldSet.FieldByNameOrID(name)
+		if err != nil && !ok {
+			return jqlField{}, fmt.Errorf("field not supported (%s)", name)
+		} else if err == nil {
+			id, schemaType = cf.ID, cf.Schema.Type
+		}
+	} else if !ok {
+		return jqlField{}, fmt.Errorf("field not supported (%s); custom field names need a custom field set", name)
+	}
+	values := func(iss *jira.Issue) []string {
+		if iss.Fields == nil {
+			return nil
+		}
+		return jqlCondense(jqlAnyValues(iss.Fields.Unknowns[id]))
+	}
+	if schemaType == "date" || schemaType == "datetime" {
+		return jqlField{kind: jqlFieldDate, time: func(iss *jira.Issue) time.Time {
+			for _, v := range values(iss) {
+				for _, layout := range []string{"2006-01-02T15:04:05.000-0700", time.RFC3339, time.DateOnly} {
+					if t, err := time.ParseInLocation(layout, v, c.opts.Location); err == nil {
+						return t
+					}
+				}
+			}
+			return time.Time{}
+		}}, nil
+	}
+	return jqlField{kind: jqlFieldAny, values: values}, nil
+}
+
+func jqlUserValues(u *jira.User) []string {
+	if u == nil {
+		return nil
+	}
+	return []string{u.AccountID, u.Name, u.Key, u.EmailAddress, u.DisplayName}
+}
+
+// jqlAnyValues returns the string values of a custom field value: a string, number, option, user
+// or sprint object, or an array of these. Object IDs are included, e.g. for `sprint = 123`.
+func jqlAnyValues(v any) []string {
+	switch x := v.(type) {
+	case nil:
+		return nil
+	case string:
+		return []string{x}
+	case float64:
+		return []string{strconv.FormatFloat(x, 'f', -1, 64)}
+	case bool:
+		return []string{strconv.FormatBool(x)}
+	case map[string]any:
+		var out []string
+		for _, k := range []string{"value", "name", "key", "displayName", "accountId", "emailAddress"} {
+			if s, ok := x[k].(string); ok {
+				out = append(out, s)
+			}
+		}
+		switch id := x["id"].(type) {
+		case string:
+			out = append(out, id)
+		case float64:
+			out = append(out, strconv.FormatFloat(id, 'f', -1, 64))
+		}
+		return out
+	case []any:
+		var out []string
+		for _, y := range x {
+			out = append(out, jqlAnyValues(y)...)
+		}
+		return out
+	default:
+		return []string{fmt.Sprintf("%v", x)}
+	}
+}
+
+// jqlCondense returns the non-empty values, trimmed.
+func jqlCondense(vals []string) []string {
+	var out []string
+	for _, v := range vals {
+		if v = strings.TrimSpace(v); v != "" {
+			out = append(out, v)
+		}
+	}
+	return out
+}
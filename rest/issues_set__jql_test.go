@@ -0,0 +1,178 @@
+package rest
+
+import (
+	"errors"
+	"sort"
+	"strings"
+	"testing"
+	"time"
+
+	jira "github.com/andygrunwald/go-jira"
+
+	"github.com/grokify/gojira"
+)
+
+func jqlTestIssuesSet(t *testing.T) *IssuesSet {
+	t.Helper()
+	day := func(s string) jira.Time {
+		dt, err := time.ParseInLocation(time.DateOnly, s, time.UTC)
+		if err != nil {
+			t.Fatalf("time.Parse() error = %v", err)
+		}
+		return jira.Time(dt)
+	}
+	issue := func(key, typ, status, statusCategory, assignee, summary, created string, labels ...string) jira.Issue {
+		iss := jira.Issue{Key: key, Fields: &jira.IssueFields{
+			Project: jira.Project{Key: "ABC", Name: "Alpha"},
+			Type:    jira.IssueType{Name: typ},
+			Status:  &jira.Status{Name: status, StatusCategory: jira.StatusCategory{Key: statusCategory}},
+			Summary: summary,
+			Created: day(created),
+			Labels:  labels}}
+		if assignee != "" {
+			iss.Fields.Assignee = &jira.User{AccountID: "id-" + assignee, DisplayName: assignee}
+		}
+		return iss
+	}
+	iss1 := issue("ABC-1", "Bug", "Open", StatusCategoryKeyNew, "Alice", "Login fails on Safari", "2024-01-10", "frontend")
+	iss1.Fields.Unknowns = map[string]any{"customfield_10016": 5.0, "customfield_10020": map[string]any{"value": "Blue"}}
+	iss2 := issue("ABC-2", "Story", "In Progress", StatusCategoryKeyIndeterminate, "Bob", "Add profile page", "2024-02-20", "frontend", "ux")
+	iss2.Fields.Unknowns = map[string]any{"customfield_10016": 8.0}
+	iss3 := issue("ABC-3", "Story", "Done", StatusCategoryKeyDone, "", "Export reports", "2024-03-01")
+	iss3.Fields.Resolution = &jira.Resolution{Name: "Done"}
+	iss3.Fields.Resolutiondate = day("2024-03-05")
+
+	set := NewIssuesSet(nil)
+	if err := set.Add(iss1, iss2, iss3); err != nil {
+		t.Fatalf("IssuesSet.Add() error = %v", err)
+	}
+	return set
+}
+
+var filterJQLTests = []struct {
+	jql  string
+	want string
+}{
+	{`project = abc`, "ABC-1,ABC-2,ABC-3"},
+	{`type = Story AND status != Done`, "ABC-2"},
+	{`status IN (Open, "In Progress") ORDER BY key DESC`, "ABC-1,ABC-2"},
+	{`statusCategory = done OR labels = ux`, "ABC-2,ABC-3"},
+	{`labels NOT IN (ux)`, "ABC-1"},
+	{`labels IS EMPTY`, "ABC-3"},
+	{`assignee = currentUser()`, "ABC-1"},
+	{`assignee = Bob OR assignee IS EMPTY`, "ABC-2,ABC-3"},
+	{`resolution = Unresolved`, "ABC-1,ABC-2"},
+	{`resolution != Unresolved`, "ABC-3"},
+	{`resolution IN (unresolved, Done)`, "ABC-1,ABC-2,ABC-3"},
+	{`resolution NOT IN (Unresolved, Done)`, ""},
+	{`resolution IS EMPTY`, "ABC-1,ABC-2"},
+	{`summary ~ "login" OR text ~ "report*"`, "ABC-1,ABC-3"},
+	{`summary !~ page`, "ABC-1,ABC-3"},
+	{`NOT (created < "2024-02-01")`, "ABC-2,ABC-3"},
+	{`created >= startOfYear() AND created <= endOfMonth(-1)`, "ABC-1,ABC-2"},
+	{`created > -4w`, "ABC-3"},
+	{`resolved >= 2024/03/05`, "ABC-3"},
+	{`cf[10016] > 5`, "ABC-2"},
+	{`customfield_10020 = blue`, "ABC-1"},
+	{`key IN (ABC-1, ABC-3) AND issue != ABC-3`, "ABC-1"},
+}
+
+func TestIssuesSetFilterJQL(t *testing.T) {
+	set := jqlTestIssuesSet(t)
+	opts := &JQLEvalOptions{
+		CurrentUser: "id-Alice",
+		Now:         time.Date(2024, time.March, 20, 12, 0, 0, 0, time.UTC),
+		Location:    time.UTC}
+	for _, tt := range filterJQLTests {
+		out, err := set.FilterJQL(tt.jql, opts)
+		if err != nil {
+			t.Errorf("IssuesSet.FilterJQL(\"%s\") error: (%s)", tt.jql, err.Error())
+			continue
+		}
+		keys := out.Keys()
+		sort.Strings(keys)
+		if got := strings.Join(keys, ","); got != tt.want {
+			t.Errorf("IssuesSet.FilterJQL(\"%s\") mismatch: want (%s), got (%s)", tt.jql, tt.want, got)
+		}
+	}
+}
+
+func TestIssuesSetKeysOrderJQL(t *testing.T) {
+	set := jqlTestIssuesSet(t)
+	iss10 := set.Items["ABC-1"]
+	iss10.Key = "ABC-10"
+	iss10.Fields = &jira.IssueFields{Status: &jira.Status{Name: "Open"},
+		Created: jira.Time(time.Date(2024, time.January, 15, 0, 0, 0, 0, time.UTC))}
+	if err := set.Add(iss10); err != nil {
+		t.Fatalf("IssuesSet.Add() error = %v", err)
+	}
+	for _, tt := range []struct {
+		jql  string
+		want string
+	}{
+		{`project = ABC`, "ABC-1,ABC-2,ABC-3,ABC-10"},
+		{`ORDER BY key DESC`, "ABC-10,ABC-3,ABC-2,ABC-1"},
+		{`ORDER BY created DESC`, "ABC-3,ABC-2,ABC-10,ABC-1"},
+		{`ORDER BY resolved`, "ABC-3,ABC-1,ABC-2,ABC-10"},
+		{`ORDER BY cf[10016] DESC`, "ABC-3,ABC-10,ABC-2,ABC-1"},
+		{`ORDER BY status, created DESC`, "ABC-3,ABC-2,ABC-10,ABC-1"},
+	} {
+		q, err := gojira.ParseJQL(tt.jql)
+		if err != nil {
+			t.Fatalf("gojira.ParseJQL(\"%s\") error: (%s)", tt.jql, err.Error())
+		}
+		keys, err := set.KeysOrderJQL(q.OrderBy, nil)
+		if err != nil {
+			t.Errorf("IssuesSet.KeysOrderJQL(\"%s\") error: (%s)", tt.jql, err.Error())
+			continue
+		}
+		if got := strings.Join(keys, ","); got != tt.want {
+			t.Errorf("IssuesSet.KeysOrderJQL(\"%s\") mismatch: want (%s), got (%s)", tt.jql, tt.want, got)
+		}
+	}
+}
+
+func TestIssuesSetFilterJQLUnsupported(t *testing.T) {
+	set := jqlTestIssuesSet(t)
+	_, err := set.FilterJQL(`project = ABC AND status WAS Open AND sprint IN openSprints() AND assignee = currentUser()`, nil)
+	var errUnsup *JQLUnsupportedError
+	if !errors.As(err, &errUnsup) {
+		t.Fatalf("IssuesSet.FilterJQL() mismatch: want (*JQLUnsupportedError), got (%v)", err)
+	}
+	var got []string
+	for _, c := range errUnsup.Clauses {
+		got = append(got, c.Clause)
+	}
+	want := `status WAS Open|sprint IN openSprints()|assignee = currentUser()`
+	if strings.Join(got, "|") != want {
+		t.Errorf("JQLUnsupportedError.Clauses mismatch: want (%s), got (%s)", want, strings.Join(got, "|"))
+	}
+}
+
+func TestIssuesSetFilterJQLSprint(t *testing.T) {
+	set := jqlTestIssuesSet(t)
+	set.Items["ABC-2"].Fields.Unknowns["customfield_10021"] = []any{
+		map[string]any{"id": 123.0, "name": "Sprint 7", "state": "active", "boardId": 4.0}}
+
+	cfs := NewCustomFieldSet()
+	if err := cfs.Add(CustomField{ID: "customfield_10021", Name: "Sprint"}); err != nil {
+		t.Fatalf("CustomFieldSet.Add() error = %v", err)
+	}
+	for _, tt := range []struct {
+		jql  string
+		want string
+	}{
+		{`sprint = 123`, "ABC-2"},
+		{`sprint = "Sprint 7"`, "ABC-2"},
+		{`sprint IN (122, 124)`, ""},
+	} {
+		out, err := set.FilterJQL(tt.jql, &JQLEvalOptions{CustomFieldSet: cfs})
+		if err != nil {
+			t.Errorf("IssuesSet.FilterJQL(\"%s\") error: (%s)", tt.jql, err.Error())
+			continue
+		}
+		if got := strings.Join(out.Keys(), ","); got != tt.want {
+			t.Errorf("IssuesSet.FilterJQL(\"%s\") mismatch: want (%s), got (%s)", tt.jql, tt.want, got)
+		}
+	}
+}
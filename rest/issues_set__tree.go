@@ -0,0 +1,148 @@
+package rest
+
+import (
+	"errors"
+	"sort"
+	"strings"
+)
+
+// IssueTree is an issue and its children in the parent/child hierarchy.
+type IssueTree struct {
+	Key            string       `json:"key"`
+	Type           string       `json:"type,omitempty"`
+	Status         string       `json:"status,omitempty"`
+	StatusCategory string       `json:"statusCategory,omitempty"`
+	Assignee       string       `json:"assignee,omitempty"`
+	Summary        string       `json:"summary,omitempty"`
+	Children       []*IssueTree `json:"children,omitempty"`
+}
+
+// Tree returns the hierarchy below an issue in the set or its parents. Children are the issues in
+// the set and its parents whose parent is the issue, sorted by key.
+func (set *IssuesSet) Tree(key string) (*IssueTree, error) {
+	key = strings.TrimSpace(key)
+	if _, ok := set.IssueOrParent(key); !ok {
+		return nil, ErrKeyNotFound
+	}
+	return set.tree(key, set.childKeys(), map[string]bool{}), nil
+}
+
+// Trees returns the hierarchies of the set and its parents, one for each issue whose parent is not
+// in the set or its parents, sorted by key.
+func (set *IssuesSet) Trees() []*IssueTree {
+	children := set.childKeys()
+	var roots []string
+	for _, key := range set.keysWithParents() {
+		iss, _ := set.IssueOrParent(key)
+		im := NewIssueMore(iss)
+		if parKey := im.ParentKey(); parKey == "" {
+			roots = append(roots, key)
+		} else if _, ok := set.IssueOrParent(parKey); !ok {
+			roots = append(roots, key)
+		}
+	}
+	var out []*IssueTree
+	seen := map[string]bool{}
+	for _, key := range roots {
+		out = append(out, set.tree(key, children, seen))
+	}
+	return out
+}
+
+// TreeRootKey returns the key of the most senior ancestor of an issue in the set or its parents.
+func (set *IssuesSet) TreeRootKey(key string) (string, error) {
+	key = strings.TrimSpace(key)
+	iss, ok := set.IssueOrParent(key)
+	if !ok {
+		return "", ErrKeyNotFound
+	}
+	seen := map[string]bool{key: true}
+	for {
+		im := NewIssueMore(iss)
+		parKey := im.ParentKey()
+		if parKey == "" {
+			return key, nil
+		} else if seen[parKey] {
+			return "", errors.New("issue hierarchy has a cycle")
+		}
+		par, ok := set.IssueOrParent(parKey)
+		if !ok {
+			return key, nil
+		}
+		seen[parKey] = true
+		key, iss = parKey, par
+	}
+}
+
+// Walk calls fn for the tree and each descendant, depth first, with the depth below the tree. If fn
+// returns an error, the walk stops and returns it.
+func (t *IssueTree) Walk(fn func(node *IssueTree, depth int) error) error {
+	var walk func(node *IssueTree, depth int) error
+	walk = func(node *IssueTree, depth int) error {
+		if err := fn(node, depth); err != nil {
+			return err
+		}
+		for _, c := range node.Children {
+			if err := walk(c, depth+1); err != nil {
+				return err
+			}
+		}
+		return nil
+	}
+	return walk(t, 0)
+}
+
+// Len returns the number of issues in the tree.
+func (t *IssueTree) Len() int {
+	n := 0
+	_ = t.Walk(func(_ *IssueTree, _ int) error { n++; return nil })
+	return n
+}
+
+func (set *IssuesSet) tree(key string, children map[string][]string, seen map[string]bool) *IssueTree {
+	seen[key] = true
+	t := &IssueTree{Key: key}
+	if iss, ok := set.IssueOrParent(key); ok {
+		im := NewIssueMore(iss)
+		t.Type = im.Type()
+		t.Status = im.Status()
+		t.Assignee = im.AssigneeName()
+		t.Summary = im.Summary()
+		if iss.Fields != nil && iss.Fields.Status != nil {
+			t.StatusCategory = iss.Fields.Status.StatusCategory.Key
+		}
+	}
+	for _, childKey := range children[key] {
+		if !seen[childKey] {
+			t.Children = append(t.Children, set.tree(childKey, children, seen))
+		}
+	}
+	return t
+}
+
+// childKeys returns the sorted child keys of each parent key in the set and its parents.
+func (set *IssuesSet) childKeys() map[string][]string {
+	out := map[string][]string{}
+	for _, key := range set.keysWithParents() {
+		iss, _ := set.IssueOrParent(key)
+		im := NewIssueMore(iss)
+		if parKey := im.ParentKey(); parKey != "" {
+			out[parKey] = append(out[parKey], key)
+		}
+	}
+	return out
+}
+
+// keysWithParents returns the keys of the set and its parents, sorted.
+func (set *IssuesSet) keysWithParents() []string {
+	keys := set.Keys()
+	if set.Parents != nil {
+		for key := range set.Parents.Items {
+			if _, ok := set.Items[key]; !ok {
+				keys = append(keys, key)
+			}
+		}
+	}
+	sort.Strings(keys)
+	return keys
+}
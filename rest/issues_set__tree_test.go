@@ -0,0 +1,50 @@
+package rest
+
+import (
+	"strings"
+	"testing"
+
+	jira "github.com/andygrunwald/go-jira"
+)
+
+func TestIssuesSetTree(t *testing.T) {
+	issue := func(key, typ, parKey string) jira.Issue {
+		iss := jira.Issue{Key: key, Fields: &jira.IssueFields{Type: jira.IssueType{Name: typ}, Summary: "Issue " + key}}
+		if parKey != "" {
+			iss.Fields.Parent = &jira.Parent{Key: parKey}
+		}
+		return iss
+	}
+	set := NewIssuesSet(nil)
+	set.Parents = NewIssuesSet(nil)
+	if err := set.Parents.Add(issue("ABC-1", "Initiative", ""), issue("ABC-2", "Epic", "ABC-1")); err != nil {
+		t.Fatalf("IssuesSet.Add() error = %v", err)
+	}
+	if err := set.Add(issue("ABC-4", "Story", "ABC-2"), issue("ABC-3", "Story", "ABC-2"),
+		issue("ABC-5", "Sub-task", "ABC-3"), issue("XYZ-1", "Story", "XYZ-0")); err != nil {
+		t.Fatalf("IssuesSet.Add() error = %v", err)
+	}
+
+	root, err := set.TreeRootKey("ABC-5")
+	if err != nil || root != "ABC-1" {
+		t.Errorf("IssuesSet.TreeRootKey() mismatch: want (%s), got (%s, %v)", "ABC-1", root, err)
+	}
+	tree, err := set.Tree(root)
+	if err != nil {
+		t.Fatalf("IssuesSet.Tree() error = %v", err)
+	}
+	var lines []string
+	_ = tree.Walk(func(node *IssueTree, depth int) error {
+		lines = append(lines, strings.Repeat(".", depth)+node.Key)
+		return nil
+	})
+	if got, want := strings.Join(lines, " "), "ABC-1 .ABC-2 ..ABC-3 ...ABC-5 ..ABC-4"; got != want {
+		t.Errorf("IssueTree.Walk() mismatch: want (%s), got (%s)", want, got)
+	}
+	if got := len(set.Trees()); got != 2 {
+		t.Errorf("IssuesSet.Trees() mismatch: want (%d), got (%d)", 2, got)
+	}
+	if _, err := set.Tree("ABC-9"); err == nil {
+		t.Errorf("IssuesSet.Tree() mismatch: want error, got nil")
+	}
+}
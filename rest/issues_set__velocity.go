@@ -0,0 +1,170 @@
+package rest
+
+import (
+	"errors"
+	"fmt"
+	"sort"
+	"strconv"
+	"time"
+
+	jira "github.com/andygrunwald/go-jira"
+	"github.com/grokify/gocharts/v2/data/table"
+)
+
+const (
+	VelocitySprintsDefault = 6 // closed sprints in a velocity report
+	VelocityWindowDefault  = 3 // sprints in a velocity rolling average
+)
+
+// SprintVelocity is the commitment and outcome of a sprint. Committed work is the scope when the
+// sprint started. Completed work was done by the end of the sprint and not before it started.
+// Carry-over is the scope left not done at the end of the sprint. Points are as of the time
+// counted.
+type SprintVelocity struct {
+	SprintID        int       `json:"sprintId"`
+	SprintName      string    `json:"sprintName"`
+	Start           time.Time `json:"start"`
+	End             time.Time `json:"end"`
+	CommittedIssues int       `json:"committedIssues"`
+	CommittedPoints float64   `json:"committedPoints"`
+	AddedIssues     int       `json:"addedIssues"`
+	AddedPoints     float64   `json:"addedPoints"`
+	RemovedIssues   int       `json:"removedIssues"`
+	RemovedPoints   float64   `json:"removedPoints"`
+	CompletedIssues int       `json:"completedIssues"`
+	CompletedPoints float64   `json:"completedPoints"`
+	CarryOverIssues int       `json:"carryOverIssues"`
+	CarryOverPoints float64   `json:"carryOverPoints"`
+	AverageIssues   float64   `json:"averageIssues"` // rolling average of completed issues; see `NewVelocityReport`
+	AveragePoints   float64   `json:"averagePoints"` // rolling average of completed points; see `NewVelocityReport`
+}
+
+// SprintVelocity returns the commitment and outcome of a sprint from the history of its issues.
+// The sprint runs from its start date to its complete date, or its end date or now if not complete.
+// `opts` sets the story points field and done statuses; see `BurndownOptions`. Issues removed
+// from the sprint are only counted if they are in the set.
+func (set *IssuesSet) SprintVelocity(sprint jira.Sprint, opts BurndownOptions) (*SprintVelocity, error) {
+	if sprint.ID == 0 {
+		return nil, ErrSprintIDCannotBeZero
+	} else if sprint.StartDate == nil || sprint.StartDate.IsZero() {
+		return nil, fmt.Errorf("sprint not started (%d)", sprint.ID)
+	}
+	start := sprint.StartDate.UTC()
+	end := time.Now().UTC()
+	if sprint.CompleteDate != nil && !sprint.CompleteDate.IsZero() {
+		end = sprint.CompleteDate.UTC()
+	} else if sprint.EndDate != nil && !sprint.EndDate.IsZero() && sprint.EndDate.Before(end) {
+		end = sprint.EndDate.UTC()
+	}
+	if end.Before(start) {
+		return nil, errors.New("sprint end is before start")
+	}
+	opts.SprintID = sprint.ID
+	m, err := set.newBurndownModel(opts)
+	if err != nil {
+		return nil, err
+	}
+
+	sv := &SprintVelocity{SprintID: sprint.ID, SprintName: sprint.Name, Start: start, End: end}
+	for _, bi := range m.issues {
+		doneAtStart := false
+		if bi.inScope(start, sprint.ID) {
+			sv.CommittedIssues++
+			sv.CommittedPoints += parseFloatOrZero(bi.points.at(start))
+			doneAtStart = m.done(bi, start)
+		}
+		if !bi.inScope(end, sprint.ID) {
+			continue
+		}
+		points := parseFloatOrZero(bi.points.at(end))
+		if !m.done(bi, end) {
+			sv.CarryOverIssues++
+			sv.CarryOverPoints += points
+		} else if !doneAtStart {
+			sv.CompletedIssues++
+			sv.CompletedPoints += points
+		}
+	}
+	for _, sc := range m.scopeChanges(start, end) {
+		switch sc.Type {
+		case ScopeChangeAdded:
+			sv.AddedIssues++
+			sv.AddedPoints += sc.Points
+		case ScopeChangeRemoved:
+			sv.RemovedIssues++
+			sv.RemovedPoints -= sc.Points
+		}
+	}
+	return sv, nil
+}
+
+// VelocityReport is the velocity of a series of sprints, oldest first.
+type VelocityReport struct {
+	BoardID       int              `json:"boardId,omitempty"`
+	PointsField   string           `json:"pointsField,omitempty"`
+	Window        int              `json:"window"`
+	Sprints       []SprintVelocity `json:"sprints"`
+	AverageIssues float64          `json:"averageIssues"` // completed issues, over the last `Window` sprints
+	AveragePoints float64          `json:"averagePoints"` // completed points, over the last `Window` sprints
+}
+
+// NewVelocityReport sorts sprints by start date and sets each sprint's rolling average of
+// completed work over it and up to `window - 1` earlier sprints. A window less than 1 uses
+// `VelocityWindowDefault`.
+func NewVelocityReport(sprints []SprintVelocity, window int) *VelocityReport {
+	if window < 1 {
+		window = VelocityWindowDefault
+	}
+	r := &VelocityReport{Window: window, Sprints: append([]SprintVelocity{}, sprints...)}
+	sort.SliceStable(r.Sprints, func(i, j int) bool { return r.Sprints[i].Start.Before(r.Sprints[j].Start) })
+	for i := range r.Sprints {
+		first := max(0, i-window+1)
+		var issues int
+		var points float64
+		for _, sv := range r.Sprints[first : i+1] {
+			issues += sv.CompletedIssues
+			points += sv.CompletedPoints
+		}
+		n := float64(i + 1 - first)
+		r.Sprints[i].AverageIssues = float64(issues) / n
+		r.Sprints[i].AveragePoints = points / n
+	}
+	if n := len(r.Sprints); n > 0 {
+		r.AverageIssues = r.Sprints[n-1].AverageIssues
+		r.AveragePoints = r.Sprints[n-1].AveragePoints
+	}
+	return r
+}
+
+// Table returns the report with one row per sprint.
+func (r *VelocityReport) Table() *table.Table {
+	tbl := table.NewTable("velocity")
+	tbl.Columns = []string{"Sprint", "Start", "End",
+		"Committed Issues", "Committed Points", "Added Issues", "Added Points", "Removed Issues", "Removed Points",
+		"Completed Issues", "Completed Points", "Carry-over Issues", "Carry-over Points",
+		fmt.Sprintf("Average Issues (%d)", r.Window), fmt.Sprintf("Average Points (%d)", r.Window)}
+	tbl.FormatMap = map[int]string{1: table.FormatDate, 2: table.FormatDate, 13: table.FormatFloat, 14: table.FormatFloat}
+	for i := 3; i < 13; i += 2 {
+		tbl.FormatMap[i] = table.FormatInt
+		tbl.FormatMap[i+1] = table.FormatFloat
+	}
+	ff := func(f float64) string { return strconv.FormatFloat(f, 'f', -1, 64) }
+	for _, sv := range r.Sprints {
+		tbl.Rows = append(tbl.Rows, []string{
+			sv.SprintName,
+			sv.Start.Format(time.DateOnly),
+			sv.End.Format(time.DateOnly),
+			strconv.Itoa(sv.CommittedIssues), ff(sv.CommittedPoints),
+			strconv.Itoa(sv.AddedIssues), ff(sv.AddedPoints),
+			strconv.Itoa(sv.RemovedIssues), ff(sv.RemovedPoints),
+			strconv.Itoa(sv.CompletedIssues), ff(sv.CompletedPoints),
+			strconv.Itoa(sv.CarryOverIssues), ff(sv.CarryOverPoints),
+			fmt.Sprintf("%.1f", sv.AverageIssues), fmt.Sprintf("%.1f", sv.AveragePoints)})
+	}
+	return &tbl
+}
+
+// Markdown returns the report table as Markdown.
+func (r *VelocityReport) Markdown() string {
+	return r.Table().Markdown("\n", true)
+}
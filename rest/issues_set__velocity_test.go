@@ -0,0 +1,62 @@
+package rest
+
+import (
+	"testing"
+	"time"
+
+	jira "github.com/andygrunwald/go-jira"
+)
+
+func TestSprintVelocity(t *testing.T) {
+	iss1 := statusHistoryTestIssue()
+	iss1.Fields.Unknowns = map[string]any{"customfield_10016": 3.0}
+	iss1.Changelog.Histories = append(iss1.Changelog.Histories,
+		jira.ChangelogHistory{Created: "2026-01-01T10:00:00.000+0000", Items: []jira.ChangelogItems{
+			{Field: "Sprint", From: "", To: "7"}}})
+	iss2 := &jira.Issue{
+		Key: "ABC-2",
+		Fields: &jira.IssueFields{
+			Created:  jira.Time(time.Date(2026, 1, 1, 9, 0, 0, 0, time.UTC)),
+			Status:   &jira.Status{Name: "To Do"},
+			Unknowns: map[string]any{"customfield_10016": 2.0}},
+		Changelog: &jira.Changelog{Histories: []jira.ChangelogHistory{
+			{Created: "2026-01-03T09:00:00.000+0000", Items: []jira.ChangelogItems{{Field: "Sprint", From: "6", To: "6, 7"}}}}}}
+	set := NewIssuesSet(nil)
+	if err := set.Add(*iss1, *iss2); err != nil {
+		t.Fatalf("IssuesSet.Add() error = %v", err)
+	}
+
+	start := time.Date(2026, 1, 2, 0, 0, 0, 0, time.UTC)
+	complete := time.Date(2026, 1, 5, 0, 0, 0, 0, time.UTC)
+	sv, err := set.SprintVelocity(jira.Sprint{ID: 7, Name: "Sprint 7", StartDate: &start, CompleteDate: &complete},
+		BurndownOptions{PointsField: "customfield_10016", PointsFieldName: "Story Points", DoneStatuses: []string{"Done"}})
+	if err != nil {
+		t.Fatalf("IssuesSet.SprintVelocity() error = %v", err)
+	}
+	want := SprintVelocity{SprintID: 7, SprintName: "Sprint 7", Start: start, End: complete,
+		CommittedIssues: 1, CommittedPoints: 3,
+		AddedIssues: 1, AddedPoints: 2,
+		CompletedIssues: 1, CompletedPoints: 3,
+		CarryOverIssues: 1, CarryOverPoints: 2}
+	if *sv != want {
+		t.Errorf("IssuesSet.SprintVelocity() mismatch: want (%v), got (%v)", want, *sv)
+	}
+}
+
+func TestNewVelocityReport(t *testing.T) {
+	day := func(d int) time.Time { return time.Date(2026, 1, d, 0, 0, 0, 0, time.UTC) }
+	r := NewVelocityReport([]SprintVelocity{
+		{SprintID: 3, Start: day(3), CompletedPoints: 30, CompletedIssues: 3},
+		{SprintID: 1, Start: day(1), CompletedPoints: 10, CompletedIssues: 1},
+		{SprintID: 2, Start: day(2), CompletedPoints: 20, CompletedIssues: 2}}, 2)
+	want := []float64{10, 15, 25}
+	for i, w := range want {
+		if r.Sprints[i].SprintID != i+1 || r.Sprints[i].AveragePoints != w {
+			t.Errorf("NewVelocityReport() sprint (%d) mismatch: want (id %d, average %v), got (id %d, average %v)",
+				i, i+1, w, r.Sprints[i].SprintID, r.Sprints[i].AveragePoints)
+		}
+	}
+	if r.AveragePoints != 25 || r.AverageIssues != 2.5 {
+		t.Errorf("NewVelocityReport() average mismatch: want (25, 2.5), got (%v, %v)", r.AveragePoints, r.AverageIssues)
+	}
+}
@@ -0,0 +1,149 @@
+package rest
+
+import (
+	"context"
+	"errors"
+	"net/http"
+
+	jira "github.com/andygrunwald/go-jira"
+
+	"github.com/grokify/gojira"
+)
+
+const (
+	APIV2URLJQLParse  = `rest/api/2/jql/parse`
+	APIV2URLIssueType = `rest/api/2/issuetype`
+)
+
+// JQLParseResult is the result of Jira's JQL parser for one query.
+type JQLParseResult struct {
+	Query    string   `json:"query"`
+	Errors   []string `json:"errors,omitempty"`
+	Warnings []string `json:"warnings,omitempty"`
+}
+
+// ParseJQL validates queries with Jira's JQL parser, using strict validation so unknown fields and
+// values are errors. Jira Server and Data Center do not have this endpoint and return an error that
+// matches `ErrNotFound`.
+func (svc *IssueService) ParseJQL(ctx context.Context, queries ...string) ([]JQLParseResult, error) {
+	if svc.Client == nil {
+		return nil, ErrClientCannotBeNil
+	}
+	var res struct {
+		Queries []JQLParseResult `json:"queries"`
+	}
+	req := map[string][]string{"queries": queries}
+	if err := svc.Client.doJSON(ctx, http.MethodPost, APIV2URLJQLParse+"?validation=strict", req, &res); err != nil {
+		return nil, err
+	}
+	return res.Queries, nil
+}
+
+// JQLLintOptions configures `Client.LintJQL`.
+type JQLLintOptions struct {
+	Catalog   *gojira.JQLLintCatalog // nil loads the catalog with `Client.JQLLintCatalog`
+	SkipParse bool                   // skip Jira's JQL parser and use only the local parser
+}
+
+// LintJQL checks a query with Jira's JQL parser and with `gojira.LintJQL` against the fields,
+// projects, statuses and issue types of the instance. Errors from Jira's parser are returned as
+// `gojira.JQLLintCodeInvalid` warnings with error severity. If the parser endpoint is not available,
+// as on Jira Server and Data Center, only the local parser is used.
+func (c *Client) LintJQL(ctx context.Context, jql string, opts *JQLLintOptions) (gojira.JQLLintWarnings, error) {
+	if opts == nil {
+		opts = &JQLLintOptions{}
+	}
+	cat := opts.Catalog
+	if cat == nil {
+		var err error
+		if cat, err = c.JQLLintCatalog(ctx); err != nil {
+			return nil, err
+		}
+	}
+	ws := gojira.LintJQL(jql, cat)
+	if opts.SkipParse {
+		return ws, nil
+	}
+	if c.IssueAPI == nil {
+		c.IssueAPI = NewIssueService(c)
+	}
+	results, err := c.IssueAPI.ParseJQL(ctx, jql)
+	if errors.Is(err, ErrNotFound) {
+		return ws, nil
+	} else if err != nil {
+		return nil, err
+	}
+	var out gojira.JQLLintWarnings
+	var serverErrs int
+	for _, r := range results {
+		for _, msg := range r.Errors {
+			out = append(out, gojira.JQLLintWarning{
+				Code:     gojira.JQLLintCodeInvalid,
+				Severity: gojira.JQLLintSeverityError,
+				Message:  msg})
+			serverErrs++
+		}
+	}
+	for _, w := range ws {
+		// Jira's parser is authoritative; it accepts some syntax the local parser does not.
+		if w.Code == gojira.JQLLintCodeSyntax && serverErrs == 0 {
+			continue
+		}
+		out = append(out, w)
+	}
+	return out, nil
+}
+
+// JQLLintCatalog returns the fields, projects, statuses and issue types of the instance for
+// `gojira.LintJQL`. Fields come from `Client.CustomFieldSet` if it is loaded.
+func (c *Client) JQLLintCatalog(ctx context.Context) (*gojira.JQLLintCatalog, error) {
+	if c.JiraClient == nil {
+		return nil, ErrJiraClientCannotBeNil
+	}
+	cat := &gojira.JQLLintCatalog{}
+
+	var fields CustomFields
+	if c.CustomFieldSet != nil && len(c.CustomFieldSet.Data) > 0 {
+		for _, cf := range c.CustomFieldSet.Data {
+			fields = append(fields, cf)
+		}
+	} else {
+		if c.CustomFieldAPI == nil {
+			c.CustomFieldAPI = NewCustomFieldService(c)
+		}
+		var err error
+		if fields, err = c.CustomFieldAPI.GetCustomFields(); err != nil {
+			return nil, err
+		}
+	}
+	for _, f := range fields {
+		cat.Fields = append(cat.Fields, f.Name)
+		cat.Fields = append(cat.Fields, f.ClauseNames...)
+	}
+
+	projects, resp, err := c.JiraClient.Project.GetListWithContext(ctx)
+	if err != nil {
+		return nil, JiraResponseError(resp, err)
+	} else if projects != nil {
+		for _, p := range *projects {
+			cat.Projects = append(cat.Projects, p.Key, p.Name)
+		}
+	}
+
+	statuses, resp, err := c.JiraClient.Status.GetAllStatusesWithContext(ctx)
+	if err != nil {
+		return nil, JiraResponseError(resp, err)
+	}
+	for _, s := range statuses {
+		cat.Statuses = append(cat.Statuses, s.Name)
+	}
+
+	var types []jira.IssueType
+	if err := c.doJSON(ctx, http.MethodGet, APIV2URLIssueType, nil, &types); err != nil {
+		return nil, err
+	}
+	for _, t := range types {
+		cat.IssueTypes = append(cat.IssueTypes, t.Name)
+	}
+	return cat, nil
+}
@@ -0,0 +1,69 @@
+package rest
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	jira "github.com/andygrunwald/go-jira"
+
+	"github.com/grokify/gojira"
+)
+
+func TestClientLintJQL(t *testing.T) {
+	for _, parseAvailable := range []bool{true, false} {
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			var body any
+			switch r.URL.Path {
+			case "/" + APIV2URLJQLParse:
+				if !parseAvailable {
+					w.WriteHeader(http.StatusNotFound)
+					return
+				}
+				body = map[string]any{"queries": []JQLParseResult{{
+					Query:  "project = ABC AND status = Opne",
+					Errors: []string{"The value 'Opne' does not exist for the field 'status'."}}}}
+			case APIV2URLListCustomFields:
+				body = CustomFields{{ID: "customfield_10016", Name: "Story Points", ClauseNames: []string{"cf[10016]"}}}
+			case "/rest/api/2/project":
+				body = []map[string]string{{"key": "ABC", "name": "Alpha"}}
+			case "/rest/api/2/status":
+				body = []jira.Status{{Name: "Open"}, {Name: "Done"}}
+			case "/" + APIV2URLIssueType:
+				body = []jira.IssueType{{Name: "Story"}}
+			default:
+				w.WriteHeader(http.StatusNotFound)
+				return
+			}
+			w.Header().Set("Content-Type", "application/json")
+			if err := json.NewEncoder(w).Encode(body); err != nil {
+				t.Errorf("failed to encode response: %v", err)
+			}
+		}))
+
+		jiraClient, err := jira.NewClient(nil, server.URL)
+		if err != nil {
+			t.Fatalf("failed to create jira client: %v", err)
+		}
+		client := &Client{Config: &gojira.Config{ServerURL: server.URL}, HTTPClient: server.Client(), JiraClient: jiraClient}
+		ws, err := client.LintJQL(context.Background(), "project = ABC AND status = Opne", nil)
+		server.Close()
+		if err != nil {
+			t.Fatalf("Client.LintJQL() error: (%s)", err.Error())
+		}
+		var got []string
+		for _, w := range ws {
+			got = append(got, w.Code+":"+strings.Join(w.Suggestions, ","))
+		}
+		want := "invalid:|unknown-value:Open"
+		if !parseAvailable {
+			want = "unknown-value:Open"
+		}
+		if strings.Join(got, "|") != want {
+			t.Errorf("Client.LintJQL() mismatch (parse available %v): want (%s), got (%s)", parseAvailable, want, strings.Join(got, "|"))
+		}
+	}
+}
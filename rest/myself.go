@@ -5,7 +5,6 @@ package rest
 import (
 	"context"
 	"encoding/json"
-	"fmt"
 	"io"
 	"net/http"
 
@@ -38,9 +37,9 @@ func (c *Client) MyselfUserInfo(ctx context.Context) (*oidc.UserInfo, *jira.User
 	if c.JiraClient == nil {
 		return nil, nil, nil, ErrJiraClientCannotBeNil
 	} else if u, resp, err := c.JiraClient.User.GetSelfWithContext(ctx); err != nil {
-		return nil, nil, nil, err
+		return nil, nil, nil, JiraResponseError(resp, err)
 	} else if resp.StatusCode > 299 {
-		return nil, nil, nil, fmt.Errorf("bad status code (%d)", resp.StatusCode)
+		return nil, nil, nil, JiraResponseError(resp, nil)
 	} else {
 		return UserJiraToOIDC(u, c.Config.ServerURL), u, resp, nil
 	}
@@ -0,0 +1,250 @@
+package rest
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync"
+
+	"golang.org/x/oauth2"
+)
+
+const (
+	AtlassianAuthURL                = "https://auth.atlassian.com/authorize"
+	AtlassianTokenURL               = "https://auth.atlassian.com/oauth/token" // #nosec G101 -- URL, not a credential
+	AtlassianAPIURL                 = "https://api.atlassian.com"
+	AtlassianAccessibleResourcesURL = AtlassianAPIURL + "/oauth/token/accessible-resources"
+	AtlassianAudience               = "api.atlassian.com"
+
+	OAuth2ScopeOfflineAccess = "offline_access"
+	OAuth2ScopeReadJiraWork  = "read:jira-work"
+	OAuth2ScopeWriteJiraWork = "write:jira-work"
+	OAuth2ScopeReadJiraUser  = "read:jira-user"
+)
+
+var (
+	ErrOAuth2TokenNotFound        = errors.New("oauth2 token not found")
+	ErrAccessibleResourceNotFound = errors.New("jira cloud site not found in accessible resources")
+)
+
+// OAuth2ScopesDefault are the scopes requested when none are configured. `offline_access`
+// is required to receive a refresh token.
+var OAuth2ScopesDefault = []string{OAuth2ScopeReadJiraWork, OAuth2ScopeWriteJiraWork, OAuth2ScopeReadJiraUser, OAuth2ScopeOfflineAccess}
+
+// OAuth2Config configures an Atlassian Cloud OAuth 2.0 (3LO) authorization code client.
+// If `CloudID` is empty, it is resolved from the accessible resources for the token,
+// matching `SiteURL` if set.
+type OAuth2Config struct {
+	ClientID     string
+	ClientSecret string
+	RedirectURL  string
+	Scopes       []string
+	CloudID      string
+	SiteURL      string // e.g. `https://example.atlassian.net`
+}
+
+// OAuth2 returns an `oauth2.Config` for the Atlassian authorization server.
+func (c OAuth2Config) OAuth2() *oauth2.Config {
+	scopes := c.Scopes
+	if len(scopes) == 0 {
+		scopes = OAuth2ScopesDefault
+	}
+	return &oauth2.Config{
+		ClientID:     c.ClientID,
+		ClientSecret: c.ClientSecret,
+		RedirectURL:  c.RedirectURL,
+		Scopes:       scopes,
+		Endpoint: oauth2.Endpoint{
+			AuthURL:   AtlassianAuthURL,
+			TokenURL:  AtlassianTokenURL,
+			AuthStyle: oauth2.AuthStyleInParams}}
+}
+
+// AuthCodeURL returns the URL to send the user to for consent, including the
+// `audience` and `prompt` parameters required by Atlassian.
+func (c OAuth2Config) AuthCodeURL(state string) string {
+	return c.OAuth2().AuthCodeURL(state,
+		oauth2.SetAuthURLParam("audience", AtlassianAudience),
+		oauth2.SetAuthURLParam("prompt", "consent"))
+}
+
+// TokenStore loads and persists OAuth 2.0 tokens. `SaveToken` is called whenever
+// a token is refreshed.
+type TokenStore interface {
+	Token() (*oauth2.Token, error)
+	SaveToken(tok *oauth2.Token) error
+}
+
+// FileTokenStore is a `TokenStore` that reads and writes a JSON token file.
+type FileTokenStore struct {
+	Filename string
+}
+
+// NewFileTokenStore returns a `FileTokenStore` for filename.
+func NewFileTokenStore(filename string) *FileTokenStore {
+	return &FileTokenStore{Filename: filename}
+}
+
+// Token returns the stored token or `ErrOAuth2TokenNotFound` if the file does not exist.
+func (s *FileTokenStore) Token() (*oauth2.Token, error) {
+	b, err := os.ReadFile(s.Filename)
+	if errors.Is(err, os.ErrNotExist) {
+		return nil, ErrOAuth2TokenNotFound
+	} else if err != nil {
+		return nil, err
+	}
+	tok := &oauth2.Token{}
+	if err := json.Unmarshal(b, tok); err != nil {
+		return nil, err
+	}
+	return tok, nil
+}
+
+// SaveToken writes tok to the file with owner-only permissions, creating the
+// parent directory if needed.
+func (s *FileTokenStore) SaveToken(tok *oauth2.Token) error {
+	if tok == nil {
+		return errors.New("oauth2 token cannot be nil")
+	}
+	b, err := json.MarshalIndent(tok, "", "  ")
+	if err != nil {
+		return err
+	}
+	if err := os.MkdirAll(filepath.Dir(s.Filename), 0o700); err != nil {
+		return err
+	}
+	return os.WriteFile(s.Filename, b, 0o600)
+}
+
+// storeTokenSource persists tokens to a `TokenStore` when they change.
+type storeTokenSource struct {
+	mu     sync.Mutex
+	src    oauth2.TokenSource
+	store  TokenStore
+	access string
+}
+
+func (s *storeTokenSource) Token() (*oauth2.Token, error) {
+	tok, err := s.src.Token()
+	if err != nil {
+		return nil, err
+	}
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if tok.AccessToken != s.access {
+		if err := s.store.SaveToken(tok); err != nil {
+			return nil, fmt.Errorf("save refreshed oauth2 token: %w", err)
+		}
+		s.access = tok.AccessToken
+	}
+	return tok, nil
+}
+
+// NewOAuth2TokenSource returns a token source that refreshes the token in store
+// when it expires and saves refreshed tokens back to store.
+func NewOAuth2TokenSource(ctx context.Context, cfg OAuth2Config, store TokenStore) (oauth2.TokenSource, error) {
+	if store == nil {
+		return nil, errors.New("token store cannot be nil")
+	}
+	tok, err := store.Token()
+	if err != nil {
+		return nil, err
+	}
+	return &storeTokenSource{
+		src:    cfg.OAuth2().TokenSource(ctx, tok),
+		store:  store,
+		access: tok.AccessToken}, nil
+}
+
+// AccessibleResource is a site the OAuth 2.0 token has been granted access to.
+type AccessibleResource struct {
+	ID        string   `json:"id"`
+	URL       string   `json:"url"`
+	Name      string   `json:"name"`
+	Scopes    []string `json:"scopes"`
+	AvatarURL string   `json:"avatarUrl"`
+}
+
+// AccessibleResources is a slice of `AccessibleResource`.
+type AccessibleResources []AccessibleResource
+
+// BySiteURL returns the resource matching siteURL. If siteURL is empty and there
+// is exactly one resource, it is returned.
+func (rs AccessibleResources) BySiteURL(siteURL string) (AccessibleResource, error) {
+	siteURL = strings.TrimRight(strings.TrimSpace(siteURL), "/")
+	if siteURL == "" {
+		if len(rs) == 1 {
+			return rs[0], nil
+		}
+		return AccessibleResource{}, fmt.Errorf("%w: site URL required to choose among (%d) sites", ErrAccessibleResourceNotFound, len(rs))
+	}
+	for _, r := range rs {
+		if strings.EqualFold(strings.TrimRight(r.URL, "/"), siteURL) {
+			return r, nil
+		}
+	}
+	return AccessibleResource{}, fmt.Errorf("%w: (%s)", ErrAccessibleResourceNotFound, siteURL)
+}
+
+// GetAccessibleResources returns the sites accessible with the OAuth 2.0 client hclient.
+func GetAccessibleResources(ctx context.Context, hclient *http.Client) (AccessibleResources, error) {
+	if hclient == nil {
+		return nil, errors.New("http client cannot be nil")
+	}
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, AtlassianAccessibleResourcesURL, nil)
+	if err != nil {
+		return nil, err
+	}
+	req.Header.Set("Accept", "application/json")
+	resp, err := hclient.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+	b, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return nil, err
+	} else if resp.StatusCode >= 300 {
+		return nil, fmt.Errorf("get accessible resources: status code (%d): %s", resp.StatusCode, strings.TrimSpace(string(b)))
+	}
+	var rs AccessibleResources
+	return rs, json.Unmarshal(b, &rs)
+}
+
+// JiraCloudAPIURL returns the OAuth 2.0 base URL for a Jira Cloud site, e.g.
+// `https://api.atlassian.com/ex/jira/{cloudid}`.
+func JiraCloudAPIURL(cloudID string) string {
+	return AtlassianAPIURL + "/ex/jira/" + strings.TrimSpace(cloudID)
+}
+
+// NewClientFromOAuth2 creates a new Client for Jira Cloud using an OAuth 2.0 (3LO)
+// token from store. Tokens are refreshed automatically on expiry and saved back
+// to store. The client's server URL is the `api.atlassian.com` URL for the site's cloud ID.
+func NewClientFromOAuth2(ctx context.Context, cfg OAuth2Config, store TokenStore, addCustomFieldSet bool, opts ...ClientOption) (*Client, error) {
+	ts, err := NewOAuth2TokenSource(ctx, cfg, store)
+	if err != nil {
+		return nil, err
+	}
+	hclient := &http.Client{Transport: &oauth2.Transport{Source: ts}}
+	jhclient := &http.Client{Transport: &oauth2.Transport{Source: ts}}
+
+	cloudID := strings.TrimSpace(cfg.CloudID)
+	if cloudID == "" {
+		rs, err := GetAccessibleResources(ctx, hclient)
+		if err != nil {
+			return nil, err
+		}
+		r, err := rs.BySiteURL(cfg.SiteURL)
+		if err != nil {
+			return nil, err
+		}
+		cloudID = r.ID
+	}
+	return newClientFromHTTPClients(hclient, jhclient, JiraCloudAPIURL(cloudID), addCustomFieldSet, opts...)
+}
@@ -0,0 +1,84 @@
+package rest
+
+import (
+	"errors"
+	"path/filepath"
+	"testing"
+
+	"golang.org/x/oauth2"
+)
+
+type memTokenStore struct {
+	tok   *oauth2.Token
+	saves int
+}
+
+func (s *memTokenStore) Token() (*oauth2.Token, error) { return s.tok, nil }
+func (s *memTokenStore) SaveToken(tok *oauth2.Token) error {
+	s.tok = tok
+	s.saves++
+	return nil
+}
+
+type seqTokenSource struct {
+	toks []string
+	i    int
+}
+
+func (s *seqTokenSource) Token() (*oauth2.Token, error) {
+	tok := &oauth2.Token{AccessToken: s.toks[s.i]}
+	if s.i < len(s.toks)-1 {
+		s.i++
+	}
+	return tok, nil
+}
+
+func TestStoreTokenSource(t *testing.T) {
+	store := &memTokenStore{}
+	ts := &storeTokenSource{
+		src:    &seqTokenSource{toks: []string{"a", "a", "b", "b"}},
+		store:  store,
+		access: "a"}
+	for i := 0; i < 4; i++ {
+		if _, err := ts.Token(); err != nil {
+			t.Fatalf("storeTokenSource.Token() error = %v", err)
+		}
+	}
+	if store.saves != 1 || store.tok.AccessToken != "b" {
+		t.Errorf("storeTokenSource.Token() saves mismatch: want (1, b), got (%d, %v)", store.saves, store.tok)
+	}
+}
+
+func TestFileTokenStore(t *testing.T) {
+	store := NewFileTokenStore(filepath.Join(t.TempDir(), "sub", "token.json"))
+	if _, err := store.Token(); !errors.Is(err, ErrOAuth2TokenNotFound) {
+		t.Errorf("FileTokenStore.Token() missing file error mismatch: want (%v), got (%v)", ErrOAuth2TokenNotFound, err)
+	}
+	if err := store.SaveToken(&oauth2.Token{AccessToken: "access", RefreshToken: "refresh"}); err != nil {
+		t.Fatalf("FileTokenStore.SaveToken() error = %v", err)
+	}
+	tok, err := store.Token()
+	if err != nil {
+		t.Fatalf("FileTokenStore.Token() error = %v", err)
+	} else if tok.AccessToken != "access" || tok.RefreshToken != "refresh" {
+		t.Errorf("FileTokenStore.Token() mismatch: want (access, refresh), got (%s, %s)", tok.AccessToken, tok.RefreshToken)
+	}
+}
+
+func TestAccessibleResourcesBySiteURL(t *testing.T) {
+	rs := AccessibleResources{
+		{ID: "1", URL: "https://one.atlassian.net"},
+		{ID: "2", URL: "https://two.atlassian.net"}}
+	if r, err := rs.BySiteURL("https://TWO.atlassian.net/"); err != nil || r.ID != "2" {
+		t.Errorf("AccessibleResources.BySiteURL() mismatch: want (2), got (%s, %v)", r.ID, err)
+	}
+	if _, err := rs.BySiteURL(""); !errors.Is(err, ErrAccessibleResourceNotFound) {
+		t.Errorf("AccessibleResources.BySiteURL(\"\") error mismatch: want (%v), got (%v)", ErrAccessibleResourceNotFound, err)
+	}
+	if r, err := rs[:1].BySiteURL(""); err != nil || r.ID != "1" {
+		t.Errorf("AccessibleResources.BySiteURL(\"\") single mismatch: want (1), got (%s, %v)", r.ID, err)
+	}
+	if got := JiraCloudAPIURL("abc"); got != "https://api.atlassian.com/ex/jira/abc" {
+		t.Errorf("JiraCloudAPIURL() mismatch: want (https://api.atlassian.com/ex/jira/abc), got (%s)", got)
+	}
+}
@@ -0,0 +1,181 @@
+package rest
+
+import (
+	"context"
+	"math"
+	"net/http"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/grokify/gojira"
+)
+
+const (
+	rateLimitAdaptiveMinFactor  = 1.0 / 16 // lowest rate as a fraction of the configured rate
+	rateLimitAdaptiveDecrease   = 0.5      // multiplier applied on HTTP 429
+	rateLimitAdaptiveNearLimit  = 0.75     // multiplier applied on `X-RateLimit-NearLimit: true`
+	rateLimitAdaptiveRecovery   = 0.05     // fraction of the configured rate restored per unthrottled response
+	rateLimitRemainingThreshold = 0.1      // slow down when remaining / limit drops below this
+)
+
+// RateLimiter is a token bucket limiter shared by all requests made through a
+// `Client`. In adaptive mode it reduces its rate when Jira returns rate-limit
+// headers and gradually recovers to the configured rate.
+type RateLimiter struct {
+	mu          sync.Mutex
+	baseRate    float64 // configured tokens per second
+	rate        float64 // current tokens per second
+	burst       float64
+	tokens      float64
+	last        time.Time
+	pausedUntil time.Time
+	adaptive    bool
+	now         func() time.Time
+}
+
+// NewRateLimiter returns a limiter allowing requestsPerSecond with the given burst.
+// A burst less than 1 is treated as 1. Returns nil if requestsPerSecond <= 0.
+func NewRateLimiter(requestsPerSecond float64, burst int, adaptive bool) *RateLimiter {
+	if requestsPerSecond <= 0 {
+		return nil
+	}
+	if burst < 1 {
+		burst = 1
+	}
+	return &RateLimiter{
+		baseRate: requestsPerSecond,
+		rate:     requestsPerSecond,
+		burst:    float64(burst),
+		tokens:   float64(burst),
+		adaptive: adaptive,
+		now:      time.Now}
+}
+
+// NewRateLimiterFromConfig returns a limiter using the rate limit settings on
+// `gojira.Config`. Returns nil if rate limiting is not configured.
+func NewRateLimiterFromConfig(cfg *gojira.Config) *RateLimiter {
+	if cfg == nil {
+		return nil
+	}
+	return NewRateLimiter(cfg.RateLimitPerSecond, cfg.RateLimitBurst, cfg.RateLimitAdaptive)
+}
+
+// Rate returns the current rate in requests per second, or 0 for a nil limiter.
+func (l *RateLimiter) Rate() float64 {
+	if l == nil {
+		return 0
+	}
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	return l.rate
+}
+
+// Wait blocks until a request is permitted or ctx is done. A nil limiter does not block.
+func (l *RateLimiter) Wait(ctx context.Context) error {
+	if l == nil {
+		return nil
+	}
+	for {
+		d := l.reserve()
+		if d <= 0 {
+			return nil
+		} else if err := sleepContext(ctx, d); err != nil {
+			return err
+		}
+	}
+}
+
+// reserve takes a token if one is available and returns 0, otherwise it returns
+// the time to wait before trying again.
+func (l *RateLimiter) reserve() time.Duration {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	now := l.now()
+	if now.Before(l.pausedUntil) {
+		return l.pausedUntil.Sub(now)
+	}
+	if !l.last.IsZero() {
+		l.tokens = math.Min(l.burst, l.tokens+now.Sub(l.last).Seconds()*l.rate)
+	}
+	l.last = now
+	if l.tokens >= 1 {
+		l.tokens--
+		return 0
+	}
+	return time.Duration((1 - l.tokens) / l.rate * float64(time.Second))
+}
+
+// Observe adjusts the rate from a response's rate-limit headers. It is a no-op
+// unless the limiter is adaptive.
+func (l *RateLimiter) Observe(resp *http.Response) {
+	if l == nil || !l.adaptive || resp == nil {
+		return
+	}
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	now := l.now()
+	switch {
+	case resp.StatusCode == http.StatusTooManyRequests:
+		l.setRate(l.rate * rateLimitAdaptiveDecrease)
+		l.tokens = 0
+		if d, ok := RetryAfter(resp, now); ok && now.Add(d).After(l.pausedUntil) {
+			l.pausedUntil = now.Add(d)
+		}
+	case headerNearLimit(resp.Header):
+		l.setRate(l.rate * rateLimitAdaptiveNearLimit)
+	default:
+		l.setRate(l.rate + l.baseRate*rateLimitAdaptiveRecovery)
+	}
+}
+
+func (l *RateLimiter) setRate(rate float64) {
+	l.rate = math.Max(l.baseRate*rateLimitAdaptiveMinFactor, math.Min(l.baseRate, rate))
+}
+
+// headerNearLimit returns true if Jira indicates the client is close to its limit,
+// either via `X-RateLimit-NearLimit` or a low `X-RateLimit-Remaining` to `X-RateLimit-Limit` ratio.
+func headerNearLimit(h http.Header) bool {
+	if strings.EqualFold(strings.TrimSpace(h.Get(HeaderXRateLimitNearLimit)), "true") {
+		return true
+	}
+	limit, errL := strconv.ParseFloat(strings.TrimSpace(h.Get(HeaderXRateLimitLimit)), 64)
+	remaining, errR := strconv.ParseFloat(strings.TrimSpace(h.Get(HeaderXRateLimitRemaining)), 64)
+	return errL == nil && errR == nil && limit > 0 && remaining/limit < rateLimitRemainingThreshold
+}
+
+// RateLimitTransport is an `http.RoundTripper` that waits on a shared `RateLimiter`
+// before each request.
+type RateLimitTransport struct {
+	Limiter   *RateLimiter
+	Transport http.RoundTripper
+}
+
+// NewRateLimitTransport wraps next with rate limiting. If next is nil,
+// `http.DefaultTransport` is used.
+func NewRateLimitTransport(next http.RoundTripper, l *RateLimiter) *RateLimitTransport {
+	return &RateLimitTransport{Limiter: l, Transport: next}
+}
+
+func (t *RateLimitTransport) transport() http.RoundTripper {
+	if t.Transport != nil {
+		return t.Transport
+	}
+	return http.DefaultTransport
+}
+
+// RoundTrip implements `http.RoundTripper`.
+func (t *RateLimitTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	if t.Limiter == nil {
+		return t.transport().RoundTrip(req)
+	}
+	if err := t.Limiter.Wait(req.Context()); err != nil {
+		return nil, err
+	}
+	resp, err := t.transport().RoundTrip(req)
+	if err == nil {
+		t.Limiter.Observe(resp)
+	}
+	return resp, err
+}
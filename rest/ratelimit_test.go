@@ -0,0 +1,90 @@
+package rest
+
+import (
+	"context"
+	"net/http"
+	"testing"
+	"time"
+)
+
+type fakeClock struct{ t time.Time }
+
+func (c *fakeClock) Now() time.Time          { return c.t }
+func (c *fakeClock) Advance(d time.Duration) { c.t = c.t.Add(d) }
+
+func newTestRateLimiter(rps float64, burst int, adaptive bool) (*RateLimiter, *fakeClock) {
+	clock := &fakeClock{t: time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)}
+	l := NewRateLimiter(rps, burst, adaptive)
+	l.now = clock.Now
+	return l, clock
+}
+
+func TestRateLimiterReserve(t *testing.T) {
+	l, clock := newTestRateLimiter(2, 3, false)
+	for i := 0; i < 3; i++ {
+		if d := l.reserve(); d != 0 {
+			t.Fatalf("RateLimiter.reserve() burst request (%d): want (0), got (%v)", i, d)
+		}
+	}
+	if d := l.reserve(); d != 500*time.Millisecond {
+		t.Errorf("RateLimiter.reserve() after burst: want (500ms), got (%v)", d)
+	}
+	clock.Advance(500 * time.Millisecond)
+	if d := l.reserve(); d != 0 {
+		t.Errorf("RateLimiter.reserve() after refill: want (0), got (%v)", d)
+	}
+}
+
+func TestRateLimiterAdaptive(t *testing.T) {
+	l, _ := newTestRateLimiter(10, 1, true)
+
+	h := http.Header{}
+	h.Set(HeaderRetryAfter, "2")
+	l.Observe(&http.Response{StatusCode: http.StatusTooManyRequests, Header: h})
+	if r := l.Rate(); r != 5 {
+		t.Errorf("RateLimiter.Rate() after 429: want (5), got (%v)", r)
+	}
+	if d := l.reserve(); d != 2*time.Second {
+		t.Errorf("RateLimiter.reserve() after Retry-After: want (2s), got (%v)", d)
+	}
+
+	h = http.Header{}
+	h.Set(HeaderXRateLimitNearLimit, "true")
+	l.Observe(&http.Response{StatusCode: http.StatusOK, Header: h})
+	if r := l.Rate(); r != 3.75 {
+		t.Errorf("RateLimiter.Rate() after near limit: want (3.75), got (%v)", r)
+	}
+
+	for i := 0; i < 100; i++ {
+		l.Observe(&http.Response{StatusCode: http.StatusOK, Header: http.Header{}})
+	}
+	if r := l.Rate(); r != 10 {
+		t.Errorf("RateLimiter.Rate() after recovery: want (10), got (%v)", r)
+	}
+
+	for i := 0; i < 100; i++ {
+		l.Observe(&http.Response{StatusCode: http.StatusTooManyRequests, Header: http.Header{}})
+	}
+	if r := l.Rate(); r != 10.0/16 {
+		t.Errorf("RateLimiter.Rate() floor: want (%v), got (%v)", 10.0/16, r)
+	}
+}
+
+func TestRateLimiterNonAdaptive(t *testing.T) {
+	l, _ := newTestRateLimiter(10, 1, false)
+	l.Observe(&http.Response{StatusCode: http.StatusTooManyRequests, Header: http.Header{}})
+	if r := l.Rate(); r != 10 {
+		t.Errorf("RateLimiter.Rate() non-adaptive after 429: want (10), got (%v)", r)
+	}
+}
+
+func TestRateLimiterNil(t *testing.T) {
+	var l *RateLimiter
+	if r := l.Rate(); r != 0 {
+		t.Errorf("RateLimiter.Rate() nil limiter: want (0), got (%v)", r)
+	}
+	if err := l.Wait(context.Background()); err != nil {
+		t.Errorf("RateLimiter.Wait() nil limiter: want (nil), got (%v)", err)
+	}
+	l.Observe(&http.Response{StatusCode: http.StatusTooManyRequests})
+}
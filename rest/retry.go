@@ -0,0 +1,241 @@
+package rest
+
+import (
+	"context"
+	"io"
+	"math/rand/v2"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/grokify/gojira"
+)
+
+const (
+	HeaderRetryAfter          = "Retry-After"
+	HeaderXRateLimitLimit     = "X-RateLimit-Limit"
+	HeaderXRateLimitRemaining = "X-RateLimit-Remaining"
+	HeaderXRateLimitReset     = "X-RateLimit-Reset"
+	HeaderXRateLimitNearLimit = "X-RateLimit-NearLimit"
+
+	RetryBaseDelayDefault = 500 * time.Millisecond
+	RetryMaxDelayDefault  = 30 * time.Second
+
+	retryDrainLimit = 64 << 10
+)
+
+// RetryPolicy controls how `RetryTransport` retries failed requests. Requests
+// are retried on HTTP 429, on 5xx responses other than 501 and 505, and on
+// transport errors. Non-idempotent methods (`POST`, `PATCH`) are only retried
+// when `RetryNonIdempotent` is set.
+type RetryPolicy struct {
+	MaxAttempts        int           // total attempts including the first; values <= 1 disable retries
+	BaseDelay          time.Duration // base for exponential backoff
+	MaxDelay           time.Duration // cap for computed exponential backoff
+	MaxWait            time.Duration // give up if the server asks to wait longer than this; 0 means no limit
+	RetryNonIdempotent bool
+}
+
+// NewRetryPolicy returns a `RetryPolicy` using the retry settings on `gojira.Config`.
+// If cfg is nil, `gojira.NewConfigDefault()` is used.
+func NewRetryPolicy(cfg *gojira.Config) RetryPolicy {
+	if cfg == nil {
+		cfg = gojira.NewConfigDefault()
+	}
+	return RetryPolicy{
+		MaxAttempts: cfg.RetryMaxAttempts,
+		BaseDelay:   RetryBaseDelayDefault,
+		MaxDelay:    RetryMaxDelayDefault,
+		MaxWait:     cfg.RetryMaxWait,
+	}
+}
+
+// Backoff returns the jittered exponential backoff for a zero-based retry number.
+func (p RetryPolicy) Backoff(retry int) time.Duration {
+	base := p.BaseDelay
+	if base <= 0 {
+		base = RetryBaseDelayDefault
+	}
+	maxDelay := p.MaxDelay
+	if maxDelay <= 0 {
+		maxDelay = RetryMaxDelayDefault
+	}
+	d := base
+	for i := 0; i < retry && d < maxDelay; i++ {
+		d *= 2
+	}
+	if d > maxDelay {
+		d = maxDelay
+	}
+	// "equal jitter": wait at least half the backoff, up to the full backoff.
+	half := d / 2
+	return half + rand.N(half+1) // #nosec G404 -- jitter does not need a secure source
+}
+
+type retryPolicyContextKey struct{}
+
+// ContextWithRetryPolicy returns a context that overrides the transport's
+// `RetryPolicy` for requests made with it.
+func ContextWithRetryPolicy(ctx context.Context, p RetryPolicy) context.Context {
+	return context.WithValue(ctx, retryPolicyContextKey{}, p)
+}
+
+// RetryPolicyFromContext returns a `RetryPolicy` set with `ContextWithRetryPolicy`.
+func RetryPolicyFromContext(ctx context.Context) (RetryPolicy, bool) {
+	if ctx == nil {
+		return RetryPolicy{}, false
+	}
+	p, ok := ctx.Value(retryPolicyContextKey{}).(RetryPolicy)
+	return p, ok
+}
+
+// RetryTransport is an `http.RoundTripper` that retries rate-limited and
+// transient failures, honoring Jira's `Retry-After` and `X-RateLimit-Reset` headers.
+type RetryTransport struct {
+	Policy    RetryPolicy
+	Transport http.RoundTripper
+}
+
+// NewRetryTransport wraps next with retry handling. If next is nil,
+// `http.DefaultTransport` is used.
+func NewRetryTransport(next http.RoundTripper, p RetryPolicy) *RetryTransport {
+	return &RetryTransport{Policy: p, Transport: next}
+}
+
+func (t *RetryTransport) transport() http.RoundTripper {
+	if t.Transport != nil {
+		return t.Transport
+	}
+	return http.DefaultTransport
+}
+
+// RoundTrip implements `http.RoundTripper`.
+func (t *RetryTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	p := t.Policy
+	if pCtx, ok := RetryPolicyFromContext(req.Context()); ok {
+		p = pCtx
+	}
+	if p.MaxAttempts <= 1 ||
+		(!MethodIsIdempotent(req.Method) && !p.RetryNonIdempotent) ||
+		(req.Body != nil && req.Body != http.NoBody && req.GetBody == nil) {
+		return t.transport().RoundTrip(req)
+	}
+
+	for attempt := 1; ; attempt++ {
+		reqTry := req
+		if attempt > 1 && req.GetBody != nil {
+			body, err := req.GetBody()
+			if err != nil {
+				return nil, err
+			}
+			reqTry = req.Clone(req.Context())
+			reqTry.Body = body
+		}
+		resp, err := t.transport().RoundTrip(reqTry)
+		if attempt >= p.MaxAttempts {
+			return resp, err
+		}
+		var wait time.Duration
+		if err != nil {
+			if req.Context().Err() != nil {
+				return resp, err
+			}
+			wait = p.Backoff(attempt - 1)
+		} else if !StatusCodeIsRetryable(resp.StatusCode) {
+			return resp, nil
+		} else if d, ok := RetryAfter(resp, time.Now()); ok {
+			if p.MaxWait > 0 && d > p.MaxWait {
+				return resp, nil
+			}
+			wait = d
+		} else {
+			wait = p.Backoff(attempt - 1)
+		}
+		if p.MaxWait > 0 && wait > p.MaxWait {
+			wait = p.MaxWait
+		}
+		if resp != nil {
+			drainClose(resp.Body)
+		}
+		if err := sleepContext(req.Context(), wait); err != nil {
+			return nil, err
+		}
+	}
+}
+
+// MethodIsIdempotent returns true for HTTP methods that are safe to retry.
+func MethodIsIdempotent(method string) bool {
+	switch strings.ToUpper(strings.TrimSpace(method)) {
+	case "", http.MethodGet, http.MethodHead, http.MethodOptions, http.MethodTrace,
+		http.MethodPut, http.MethodDelete:
+		return true
+	default:
+		return false
+	}
+}
+
+// StatusCodeIsRetryable returns true for HTTP status codes that indicate a
+// rate limit or a transient server error.
+func StatusCodeIsRetryable(statusCode int) bool {
+	switch {
+	case statusCode == http.StatusTooManyRequests:
+		return true
+	case statusCode == http.StatusNotImplemented,
+		statusCode == http.StatusHTTPVersionNotSupported:
+		return false
+	default:
+		return statusCode >= 500 && statusCode <= 599
+	}
+}
+
+// RetryAfter returns the wait requested by the server using the `Retry-After`
+// header (seconds or HTTP date) and, failing that, Jira Cloud's `X-RateLimit-Reset`
+// header (ISO 8601 timestamp).
+func RetryAfter(resp *http.Response, now time.Time) (time.Duration, bool) {
+	if resp == nil {
+		return 0, false
+	}
+	if v := strings.TrimSpace(resp.Header.Get(HeaderRetryAfter)); v != "" {
+		if secs, err := strconv.Atoi(v); err == nil {
+			return nonNegative(time.Duration(secs) * time.Second), true
+		} else if dt, err := http.ParseTime(v); err == nil {
+			return nonNegative(dt.Sub(now)), true
+		}
+	}
+	if v := strings.TrimSpace(resp.Header.Get(HeaderXRateLimitReset)); v != "" {
+		if dt, err := time.Parse(time.RFC3339, v); err == nil {
+			return nonNegative(dt.Sub(now)), true
+		}
+	}
+	return 0, false
+}
+
+func nonNegative(d time.Duration) time.Duration {
+	if d < 0 {
+		return 0
+	}
+	return d
+}
+
+func drainClose(body io.ReadCloser) {
+	if body == nil {
+		return
+	}
+	_, _ = io.Copy(io.Discard, io.LimitReader(body, retryDrainLimit))
+	_ = body.Close()
+}
+
+func sleepContext(ctx context.Context, d time.Duration) error {
+	if d <= 0 {
+		return ctx.Err()
+	}
+	timer := time.NewTimer(d)
+	defer timer.Stop()
+	select {
+	case <-ctx.Done():
+		return ctx.Err()
+	case <-timer.C:
+		return nil
+	}
+}
@@ -0,0 +1,135 @@
+package rest
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+func newRetryTestServer(t *testing.T, failures int32, status int, header http.Header) (*httptest.Server, *atomic.Int32) {
+	t.Helper()
+	var calls atomic.Int32
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		n := calls.Add(1)
+		if r.Body != nil {
+			if b, err := io.ReadAll(r.Body); err != nil {
+				t.Errorf("read body: %v", err)
+			} else if r.Method == http.MethodPost && string(b) != "payload" {
+				t.Errorf("attempt (%d) body mismatch: want (payload), got (%s)", n, string(b))
+			}
+		}
+		if n <= failures {
+			for k, vals := range header {
+				for _, v := range vals {
+					w.Header().Add(k, v)
+				}
+			}
+			w.WriteHeader(status)
+			return
+		}
+		w.WriteHeader(http.StatusOK)
+	}))
+	t.Cleanup(server.Close)
+	return server, &calls
+}
+
+var retryTransportTests = []struct {
+	name       string
+	method     string
+	failures   int32
+	status     int
+	header     http.Header
+	policy     RetryPolicy
+	wantStatus int
+	wantCalls  int32
+}{
+	{"429 retry-after", http.MethodGet, 2, http.StatusTooManyRequests, http.Header{HeaderRetryAfter: {"0"}},
+		RetryPolicy{MaxAttempts: 4, BaseDelay: time.Millisecond}, http.StatusOK, 3},
+	{"503 backoff", http.MethodGet, 1, http.StatusServiceUnavailable, nil,
+		RetryPolicy{MaxAttempts: 4, BaseDelay: time.Millisecond}, http.StatusOK, 2},
+	{"attempts exhausted", http.MethodGet, 5, http.StatusBadGateway, nil,
+		RetryPolicy{MaxAttempts: 3, BaseDelay: time.Millisecond}, http.StatusBadGateway, 3},
+	{"400 not retried", http.MethodGet, 1, http.StatusBadRequest, nil,
+		RetryPolicy{MaxAttempts: 4, BaseDelay: time.Millisecond}, http.StatusBadRequest, 1},
+	{"501 not retried", http.MethodGet, 1, http.StatusNotImplemented, nil,
+		RetryPolicy{MaxAttempts: 4, BaseDelay: time.Millisecond}, http.StatusNotImplemented, 1},
+	{"post not retried", http.MethodPost, 1, http.StatusServiceUnavailable, nil,
+		RetryPolicy{MaxAttempts: 4, BaseDelay: time.Millisecond}, http.StatusServiceUnavailable, 1},
+	{"post retried when allowed", http.MethodPost, 1, http.StatusServiceUnavailable, nil,
+		RetryPolicy{MaxAttempts: 4, BaseDelay: time.Millisecond, RetryNonIdempotent: true}, http.StatusOK, 2},
+	{"retry-after exceeds max wait", http.MethodGet, 1, http.StatusTooManyRequests, http.Header{HeaderRetryAfter: {"120"}},
+		RetryPolicy{MaxAttempts: 4, BaseDelay: time.Millisecond, MaxWait: time.Second}, http.StatusTooManyRequests, 1},
+	{"disabled", http.MethodGet, 1, http.StatusServiceUnavailable, nil,
+		RetryPolicy{}, http.StatusServiceUnavailable, 1},
+}
+
+func TestRetryTransport(t *testing.T) {
+	for _, tt := range retryTransportTests {
+		t.Run(tt.name, func(t *testing.T) {
+			server, calls := newRetryTestServer(t, tt.failures, tt.status, tt.header)
+			hclient := &http.Client{Transport: NewRetryTransport(nil, tt.policy)}
+			req, err := http.NewRequest(tt.method, server.URL, strings.NewReader("payload"))
+			if err != nil {
+				t.Fatalf("http.NewRequest() error = %v", err)
+			}
+			resp, err := hclient.Do(req)
+			if err != nil {
+				t.Fatalf("RetryTransport.RoundTrip() error = %v", err)
+			}
+			defer resp.Body.Close()
+			if resp.StatusCode != tt.wantStatus {
+				t.Errorf("RetryTransport.RoundTrip() status mismatch: want (%d), got (%d)", tt.wantStatus, resp.StatusCode)
+			}
+			if got := calls.Load(); got != tt.wantCalls {
+				t.Errorf("RetryTransport.RoundTrip() calls mismatch: want (%d), got (%d)", tt.wantCalls, got)
+			}
+		})
+	}
+}
+
+func TestRetryTransportContextOverride(t *testing.T) {
+	server, calls := newRetryTestServer(t, 1, http.StatusServiceUnavailable, nil)
+	hclient := &http.Client{Transport: NewRetryTransport(nil, RetryPolicy{MaxAttempts: 4, BaseDelay: time.Millisecond})}
+	ctx := ContextWithRetryPolicy(context.Background(), RetryPolicy{})
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, server.URL, nil)
+	if err != nil {
+		t.Fatalf("http.NewRequestWithContext() error = %v", err)
+	}
+	resp, err := hclient.Do(req)
+	if err != nil {
+		t.Fatalf("RetryTransport.RoundTrip() error = %v", err)
+	}
+	resp.Body.Close()
+	if resp.StatusCode != http.StatusServiceUnavailable || calls.Load() != 1 {
+		t.Errorf("RetryTransport.RoundTrip() with context override: want (503, 1 call), got (%d, %d calls)", resp.StatusCode, calls.Load())
+	}
+}
+
+func TestRetryAfter(t *testing.T) {
+	now := time.Date(2024, 1, 2, 3, 4, 5, 0, time.UTC)
+	tests := []struct {
+		key    string
+		value  string
+		want   time.Duration
+		wantOK bool
+	}{
+		{HeaderRetryAfter, "7", 7 * time.Second, true},
+		{HeaderRetryAfter, now.Add(30 * time.Second).Format(http.TimeFormat), 30 * time.Second, true},
+		{HeaderXRateLimitReset, now.Add(time.Minute).Format(time.RFC3339), time.Minute, true},
+		{HeaderXRateLimitReset, now.Add(-time.Minute).Format(time.RFC3339), 0, true},
+		{HeaderXRateLimitRemaining, "0", 0, false},
+	}
+	for _, tt := range tests {
+		h := http.Header{}
+		h.Set(tt.key, tt.value)
+		got, ok := RetryAfter(&http.Response{Header: h}, now)
+		if got != tt.want || ok != tt.wantOK {
+			t.Errorf("RetryAfter(%s: %s) mismatch: want (%v, %v), got (%v, %v)", tt.key, tt.value, tt.want, tt.wantOK, got, ok)
+		}
+	}
+}
@@ -0,0 +1,64 @@
+package rest
+
+import (
+	"strings"
+
+	"github.com/grokify/mogo/type/stringsutil"
+)
+
+const (
+	ExpandFieldNames          = "names"
+	ExpandFieldRenderedFields = "renderedFields"
+	ExpandFieldSchema         = "schema"
+
+	FieldsAll       = "*all"
+	FieldsNavigable = "*navigable"
+)
+
+// SearchOptions controls the fields, expansions and page size requested by the
+// search functions on `IssueService`. The zero value requests all fields with
+// each endpoint's default expansions and page size.
+type SearchOptions struct {
+	Fields   []string // field IDs or names, e.g. `key`, `summary`, `customfield_10010`; `*all` and `*navigable` are supported
+	Expand   []string // e.g. `changelog`, `renderedFields`, `names`, `schema`
+	PageSize int      // results per request; 0 uses the endpoint default
+}
+
+// searchOptionsOrDefault returns the first of opts, or the zero value if none are given.
+func searchOptionsOrDefault(opts []SearchOptions) SearchOptions {
+	if len(opts) > 0 {
+		return opts[0]
+	}
+	return SearchOptions{}
+}
+
+// FieldsOrDefault returns the requested fields, or `*all` if none are set.
+func (opts SearchOptions) FieldsOrDefault() []string {
+	if fields := stringsutil.SliceCondenseSpace(opts.Fields, true, false); len(fields) > 0 {
+		return fields
+	}
+	return []string{FieldsAll}
+}
+
+// ExpandOrDefault returns the requested expansions as a comma-separated string,
+// or def if none are set.
+func (opts SearchOptions) ExpandOrDefault(def string) string {
+	if expand := stringsutil.SliceCondenseSpace(opts.Expand, true, false); len(expand) > 0 {
+		return strings.Join(expand, ",")
+	}
+	return def
+}
+
+// PageSizeOrDefault returns the requested page size, or def if it is not set.
+func (opts SearchOptions) PageSizeOrDefault(def int) int {
+	if opts.PageSize > 0 {
+		return opts.PageSize
+	}
+	return def
+}
+
+// ParseFieldsCSV splits a comma-separated list of fields or expansions, as used by
+// CLI flags and MCP arguments.
+func ParseFieldsCSV(s string) []string {
+	return stringsutil.SliceCondenseSpace(strings.Split(s, ","), true, false)
+}
@@ -0,0 +1,172 @@
+package rest
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+	"time"
+
+	jira "github.com/andygrunwald/go-jira"
+)
+
+// Jira Software sprint states.
+const (
+	SprintStateActive = "active"
+	SprintStateFuture = "future"
+	SprintStateClosed = "closed"
+
+	// AgileIssuesMaxPerRequest is the maximum number of issues the Agile API moves or ranks in one request.
+	AgileIssuesMaxPerRequest = 50
+)
+
+var ErrSprintIDCannotBeZero = errors.New("sprint id cannot be zero")
+
+// SprintService provides access to Jira Software sprints using the Agile REST API at `/rest/agile/1.0/sprint`.
+type SprintService struct {
+	Client *Client
+}
+
+func NewSprintService(client *Client) *SprintService {
+	return &SprintService{Client: client}
+}
+
+// SprintCreateRequest is the body to create a sprint. Dates are optional.
+type SprintCreateRequest struct {
+	Name          string     `json:"name"`
+	OriginBoardID int        `json:"originBoardId"`
+	StartDate     *time.Time `json:"startDate,omitempty"`
+	EndDate       *time.Time `json:"endDate,omitempty"`
+	Goal          string     `json:"goal,omitempty"`
+}
+
+// sprintUpdateRequest is the body to partially update a sprint.
+type sprintUpdateRequest struct {
+	State     string     `json:"state,omitempty"`
+	StartDate *time.Time `json:"startDate,omitempty"`
+	EndDate   *time.Time `json:"endDate,omitempty"`
+}
+
+// Sprint returns a sprint by ID.
+func (svc *SprintService) Sprint(ctx context.Context, sprintID int) (*jira.Sprint, error) {
+	if sprintID == 0 {
+		return nil, ErrSprintIDCannotBeZero
+	}
+	sprint := &jira.Sprint{}
+	if err := svc.Client.doJSON(ctx, http.MethodGet, fmt.Sprintf("rest/agile/1.0/sprint/%d", sprintID), nil, sprint); err != nil {
+		return nil, err
+	}
+	return sprint, nil
+}
+
+// IssuesSet returns the issues in a sprint, reading every page. Options set the fields and
+// expansions returned, as for `IssueService.SearchIssues`.
+func (svc *SprintService) IssuesSet(ctx context.Context, sprintID int, opts ...SearchOptions) (*IssuesSet, error) {
+	if sprintID == 0 {
+		return nil, ErrSprintIDCannotBeZero
+	}
+	so := searchOptionsOrDefault(opts)
+	set := NewIssuesSet(svc.Client.Config)
+	for startAt := 0; ; {
+		qry := url.Values{
+			ParamStartAt:    {strconv.Itoa(startAt)},
+			ParamMaxResults: {strconv.Itoa(so.PageSizeOrDefault(MaxResults))},
+			ParamFields:     {strings.Join(so.FieldsOrDefault(), ",")}}
+		if expand := so.ExpandOrDefault(""); expand != "" {
+			qry.Set("expand", expand)
+		}
+		var ir IssuesResponse
+		if err := svc.Client.doJSON(ctx, http.MethodGet, fmt.Sprintf("rest/agile/1.0/sprint/%d/issue?%s", sprintID, qry.Encode()), nil, &ir); err != nil {
+			return nil, err
+		} else if err := set.Add(ir.Issues...); err != nil {
+			return nil, err
+		}
+		startAt += len(ir.Issues)
+		if len(ir.Issues) == 0 || startAt >= ir.Total {
+			return set, nil
+		}
+	}
+}
+
+// MoveIssues moves issues to an open or active sprint, in requests of up to `AgileIssuesMaxPerRequest` issues.
+func (svc *SprintService) MoveIssues(ctx context.Context, sprintID int, issueKeys ...string) error {
+	if sprintID == 0 {
+		return ErrSprintIDCannotBeZero
+	}
+	return moveAgileIssues(ctx, svc.Client, fmt.Sprintf("rest/agile/1.0/sprint/%d/issue", sprintID), issueKeys)
+}
+
+// MoveIssuesToBacklog removes issues from all future and active sprints.
+func (svc *SprintService) MoveIssuesToBacklog(ctx context.Context, issueKeys ...string) error {
+	return moveAgileIssues(ctx, svc.Client, "rest/agile/1.0/backlog/issue", issueKeys)
+}
+
+func moveAgileIssues(ctx context.Context, client *Client, apiEndpoint string, issueKeys []string) error {
+	if len(issueKeys) == 0 {
+		return nil
+	}
+	for _, keys := range chunkStrings(issueKeys, AgileIssuesMaxPerRequest) {
+		if err := client.doJSON(ctx, http.MethodPost, apiEndpoint, jira.IssuesWrapper{Issues: keys}, nil); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// Create creates a future sprint on a board.
+func (svc *SprintService) Create(ctx context.Context, req SprintCreateRequest) (*jira.Sprint, error) {
+	if strings.TrimSpace(req.Name) == "" {
+		return nil, errors.New("sprint name cannot be empty")
+	} else if req.OriginBoardID == 0 {
+		return nil, errors.New("sprint board id cannot be zero")
+	}
+	sprint := &jira.Sprint{}
+	if err := svc.Client.doJSON(ctx, http.MethodPost, "rest/agile/1.0/sprint", req, sprint); err != nil {
+		return nil, err
+	}
+	return sprint, nil
+}
+
+// Start starts a future sprint. Jira requires start and end dates, which are set from `start` and
+// `end` if not zero.
+func (svc *SprintService) Start(ctx context.Context, sprintID int, start, end time.Time) (*jira.Sprint, error) {
+	req := sprintUpdateRequest{State: SprintStateActive}
+	if !start.IsZero() {
+		req.StartDate = &start
+	}
+	if !end.IsZero() {
+		req.EndDate = &end
+	}
+	return svc.update(ctx, sprintID, req)
+}
+
+// Close closes an active sprint. Open issues stay in the closed sprint unless moved first.
+func (svc *SprintService) Close(ctx context.Context, sprintID int) (*jira.Sprint, error) {
+	return svc.update(ctx, sprintID, sprintUpdateRequest{State: SprintStateClosed})
+}
+
+func (svc *SprintService) update(ctx context.Context, sprintID int, req sprintUpdateRequest) (*jira.Sprint, error) {
+	if sprintID == 0 {
+		return nil, ErrSprintIDCannotBeZero
+	}
+	sprint := &jira.Sprint{}
+	if err := svc.Client.doJSON(ctx, http.MethodPost, fmt.Sprintf("rest/agile/1.0/sprint/%d", sprintID), req, sprint); err != nil {
+		return nil, err
+	}
+	return sprint, nil
+}
+
+func chunkStrings(s []string, size int) [][]string {
+	var out [][]string
+	for len(s) > size {
+		out = append(out, s[:size])
+		s = s[size:]
+	}
+	if len(s) > 0 {
+		out = append(out, s)
+	}
+	return out
+}
@@ -0,0 +1,78 @@
+package rest
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"strings"
+	"sync"
+	"testing"
+
+	jira "github.com/andygrunwald/go-jira"
+)
+
+func TestSprintService(t *testing.T) {
+	var mu sync.Mutex
+	var requests []string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		body, _ := io.ReadAll(r.Body)
+		mu.Lock()
+		requests = append(requests, r.Method+" "+r.URL.Path+" "+strings.TrimSpace(string(body)))
+		mu.Unlock()
+		w.Header().Set("Content-Type", "application/json")
+		switch r.URL.Path {
+		case "/rest/agile/1.0/sprint/7/issue":
+			startAt, _ := strconv.Atoi(r.URL.Query().Get("startAt"))
+			_ = json.NewEncoder(w).Encode(IssuesResponse{
+				Issues: Issues{{Key: fmt.Sprintf("ABC-%d", startAt+1)}}, StartAt: startAt, Total: 2})
+		case "/rest/agile/1.0/sprint/7":
+			_, _ = w.Write([]byte(`{"id":7,"name":"Sprint 7","state":"closed"}`))
+		default:
+			w.WriteHeader(http.StatusNoContent)
+		}
+	}))
+	defer server.Close()
+
+	jiraClient, err := jira.NewClient(nil, server.URL)
+	if err != nil {
+		t.Fatalf("failed to create jira client: %v", err)
+	}
+	client := &Client{JiraClient: jiraClient}
+	svc := NewSprintService(client)
+	ctx := context.Background()
+
+	set, err := svc.IssuesSet(ctx, 7, SearchOptions{PageSize: 1})
+	if err != nil {
+		t.Fatalf("SprintService.IssuesSet() error = %v", err)
+	} else if set.Len() != 2 {
+		t.Errorf("SprintService.IssuesSet() mismatch: want (2 issues), got (%d)", set.Len())
+	}
+
+	if sprint, err := svc.Close(ctx, 7); err != nil {
+		t.Fatalf("SprintService.Close() error = %v", err)
+	} else if sprint.State != SprintStateClosed {
+		t.Errorf("SprintService.Close() state mismatch: want (%s), got (%s)", SprintStateClosed, sprint.State)
+	}
+
+	var keys []string
+	for i := range 60 {
+		keys = append(keys, fmt.Sprintf("ABC-%d", i+1))
+	}
+	requests = nil
+	if err := svc.MoveIssues(ctx, 7, keys...); err != nil {
+		t.Fatalf("SprintService.MoveIssues() error = %v", err)
+	} else if len(requests) != 2 {
+		t.Errorf("SprintService.MoveIssues() requests mismatch: want (2), got (%d)", len(requests))
+	}
+
+	requests = nil
+	if err := NewIssueService(client).RankIssues(ctx, keys, "ABC-100", ""); err != nil {
+		t.Fatalf("IssueService.RankIssues() error = %v", err)
+	} else if len(requests) != 2 || !strings.Contains(requests[0], `"rankBeforeIssue":"ABC-100"`) || !strings.Contains(requests[1], `"rankAfterIssue":"ABC-50"`) {
+		t.Errorf("IssueService.RankIssues() requests mismatch: want (before ABC-100, after ABC-50), got (%v)", requests)
+	}
+}
@@ -0,0 +1,108 @@
+package rest
+
+import (
+	"context"
+	"errors"
+
+	jira "github.com/andygrunwald/go-jira"
+
+	"github.com/grokify/gojira"
+)
+
+// Jira status category keys.
+const (
+	StatusCategoryKeyNew           = "new"
+	StatusCategoryKeyIndeterminate = "indeterminate"
+	StatusCategoryKeyDone          = "done"
+)
+
+// NewStatusCategoryConfigFromJiraStatuses maps Jira statuses to meta stages using each status's
+// Jira status category: `To Do` statuses map to `ReadyForDevelopmentName()`, `In Progress`
+// statuses to `InDevelopmentName()` and `Done` statuses to `DoneName()`. A nil stageConfig
+// uses `gojira.DefaultStageSet()`.
+func NewStatusCategoryConfigFromJiraStatuses(statuses []jira.Status, stageConfig *gojira.StageConfig) (*gojira.StatusCategoryConfig, error) {
+	if stageConfig == nil {
+		stageConfig = gojira.DefaultStageSet()
+	}
+	scc := gojira.NewStatusConfig(*stageConfig)
+	metaStages := map[string]string{
+		StatusCategoryKeyNew:           stageConfig.ReadyForDevelopmentName(),
+		StatusCategoryKeyIndeterminate: stageConfig.InDevelopmentName(),
+		StatusCategoryKeyDone:          stageConfig.DoneName(),
+	}
+	for _, st := range statuses {
+		if metaStage, ok := metaStages[st.StatusCategory.Key]; ok && metaStage != "" {
+			if err := scc.Add(st.Name, metaStage); err != nil {
+				return nil, err
+			}
+		}
+	}
+	return &scc, nil
+}
+
+// StatusCategoryConfigFromJiraCategories retrieves all statuses from the server and maps them to
+// meta stages using `NewStatusCategoryConfigFromJiraStatuses`.
+func (c *Client) StatusCategoryConfigFromJiraCategories(ctx context.Context, stageConfig *gojira.StageConfig) (*gojira.StatusCategoryConfig, error) {
+	if c.JiraClient == nil {
+		return nil, ErrJiraClientCannotBeNil
+	}
+	statuses, resp, err := c.JiraClient.Status.GetAllStatusesWithContext(ctx)
+	if err != nil {
+		return nil, JiraResponseError(resp, err)
+	}
+	return NewStatusCategoryConfigFromJiraStatuses(statuses, stageConfig)
+}
+
+// NewStatusCategoryConfigFromBoardColumns maps Jira statuses to meta stages named after board
+// columns, in column order. The done meta stage is the rightmost column with statuses, matching
+// Jira's sprint reports. If `statuses` is supplied, the in progress meta stages are the other
+// columns with an `In Progress` category status; otherwise they are the columns between the
+// first column and the done column.
+func NewStatusCategoryConfigFromBoardColumns(cols []BoardColumn, statuses []jira.Status) (*gojira.StatusCategoryConfig, error) {
+	var names []string
+	done := ""
+	for _, col := range cols {
+		names = append(names, col.Name)
+		if len(col.Statuses) > 0 {
+			done = col.Name
+		}
+	}
+	if done == "" {
+		return nil, errors.New("board has no columns with statuses")
+	}
+
+	categories := map[string]string{}
+	for _, st := range statuses {
+		categories[st.ID] = st.StatusCategory.Key
+	}
+	var inProgress []string
+	for i, col := range cols {
+		if col.Name == done {
+			break
+		} else if len(statuses) == 0 {
+			if i > 0 {
+				inProgress = append(inProgress, col.Name)
+			}
+			continue
+		}
+		for _, id := range col.StatusIDs {
+			if categories[id] == StatusCategoryKeyIndeterminate {
+				inProgress = append(inProgress, col.Name)
+				break
+			}
+		}
+	}
+
+	scc := gojira.NewStatusConfig(gojira.StageConfig{
+		MetaStages:           names,
+		MetaStagesInProgress: inProgress,
+		MetaStageDone:        done})
+	for _, col := range cols {
+		for _, status := range col.Statuses {
+			if err := scc.Add(status, col.Name); err != nil {
+				return nil, err
+			}
+		}
+	}
+	return &scc, nil
+}
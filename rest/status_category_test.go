@@ -0,0 +1,73 @@
+package rest
+
+import (
+	"strings"
+	"testing"
+
+	jira "github.com/andygrunwald/go-jira"
+)
+
+func TestNewStatusCategoryConfigFromBoardColumns(t *testing.T) {
+	cols := []BoardColumn{
+		{Name: "Backlog", Statuses: []string{"Backlog"}, StatusIDs: []string{"1"}},
+		{Name: "Selected", Statuses: []string{"Selected"}, StatusIDs: []string{"2"}},
+		{Name: "Doing", Statuses: []string{"In Progress", "In Review"}, StatusIDs: []string{"3", "4"}},
+		{Name: "Done", Statuses: []string{"Done"}, StatusIDs: []string{"5"}},
+		{Name: "Empty"}}
+	statuses := []jira.Status{
+		{ID: "1", StatusCategory: jira.StatusCategory{Key: StatusCategoryKeyNew}},
+		{ID: "2", StatusCategory: jira.StatusCategory{Key: StatusCategoryKeyNew}},
+		{ID: "3", StatusCategory: jira.StatusCategory{Key: StatusCategoryKeyIndeterminate}},
+		{ID: "5", StatusCategory: jira.StatusCategory{Key: StatusCategoryKeyDone}}}
+	for _, tt := range []struct {
+		statuses   []jira.Status
+		inProgress string
+	}{
+		{statuses, "Doing"},
+		{nil, "Selected,Doing"},
+	} {
+		scc, err := NewStatusCategoryConfigFromBoardColumns(cols, tt.statuses)
+		if err != nil {
+			t.Fatalf("NewStatusCategoryConfigFromBoardColumns() error: %v", err)
+		}
+		if order := strings.Join(scc.StageConfig.Order(), ","); order != "Backlog,Selected,Doing,Done,Empty" {
+			t.Errorf("StageConfig.Order() mismatch: want (%s), got (%s)", "Backlog,Selected,Doing,Done,Empty", order)
+		}
+		if done := strings.Join(scc.StatusesDone(), ","); done != "Done" {
+			t.Errorf("StatusesDone() mismatch: want (%s), got (%s)", "Done", done)
+		}
+		if inProgress := strings.Join(scc.StageConfig.InProgressNames(), ","); inProgress != tt.inProgress {
+			t.Errorf("InProgressNames() mismatch: want (%s), got (%s)", tt.inProgress, inProgress)
+		}
+	}
+}
+
+func TestNewStatusCategoryConfigFromJiraStatuses(t *testing.T) {
+	scc, err := NewStatusCategoryConfigFromJiraStatuses([]jira.Status{
+		{Name: "To Do", StatusCategory: jira.StatusCategory{Key: StatusCategoryKeyNew}},
+		{Name: "In Progress", StatusCategory: jira.StatusCategory{Key: StatusCategoryKeyIndeterminate}},
+		{Name: "In Review", StatusCategory: jira.StatusCategory{Key: StatusCategoryKeyIndeterminate}},
+		{Name: "Done", StatusCategory: jira.StatusCategory{Key: StatusCategoryKeyDone}},
+		{Name: "Unknown", StatusCategory: jira.StatusCategory{Key: "undefined"}}}, nil)
+	if err != nil {
+		t.Fatalf("NewStatusCategoryConfigFromJiraStatuses() error: %v", err)
+	}
+	stages := scc.StageConfig
+	for _, tt := range []struct {
+		status    string
+		metaStage string
+	}{
+		{"To Do", stages.ReadyForDevelopmentName()},
+		{"In Progress", stages.InDevelopmentName()},
+		{"In Review", stages.InDevelopmentName()},
+		{"Done", stages.DoneName()},
+		{"Unknown", ""},
+	} {
+		if metaStage := scc.MetaStage(tt.status); metaStage != tt.metaStage {
+			t.Errorf("MetaStage(%q) mismatch: want (%s), got (%s)", tt.status, tt.metaStage, metaStage)
+		}
+	}
+	if done := strings.Join(scc.StatusesDone(), ","); done != "Done" {
+		t.Errorf("StatusesDone() mismatch: want (%s), got (%s)", "Done", done)
+	}
+}
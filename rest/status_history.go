@@ -0,0 +1,189 @@
+package rest
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+
+	jira "github.com/andygrunwald/go-jira"
+)
+
+const ChangelogFieldStatus = "status"
+
+// StatusTransition is a single status change from an issue changelog.
+type StatusTransition struct {
+	From     string    `json:"from"`
+	FromID   string    `json:"fromId,omitempty"`
+	To       string    `json:"to"`
+	ToID     string    `json:"toId,omitempty"`
+	Author   string    `json:"author,omitempty"`   // display name
+	AuthorID string    `json:"authorId,omitempty"` // account ID on Cloud, user name on Data Center
+	Time     time.Time `json:"time"`
+}
+
+// StatusPeriod is a span of time an issue spent in a single status.
+type StatusPeriod struct {
+	Status   string        `json:"status"`
+	Start    time.Time     `json:"start"`
+	End      time.Time     `json:"end"`
+	Duration time.Duration `json:"duration"`
+	Current  bool          `json:"current,omitempty"` // the issue is still in this status; `End` is the time the periods were computed
+}
+
+// StatusHistory is the ordered status transitions of an issue, built from its changelog.
+type StatusHistory struct {
+	Key           string             `json:"key"`
+	Created       time.Time          `json:"created"`
+	InitialStatus string             `json:"initialStatus"`
+	CurrentStatus string             `json:"currentStatus"`
+	Transitions   []StatusTransition `json:"transitions"`
+}
+
+// NewStatusHistory builds a `StatusHistory` from an issue's changelog. Transitions are sorted by time.
+// If the issue has no changelog, the history has no transitions and the issue is treated as having
+// been in its current status since creation.
+func NewStatusHistory(iss *jira.Issue) (StatusHistory, error) {
+	im := NewIssueMore(iss)
+	sh := StatusHistory{
+		Key:           im.Key(),
+		Created:       im.CreateTime(),
+		CurrentStatus: im.Status(),
+		Transitions:   []StatusTransition{}}
+	if iss == nil || iss.Changelog == nil {
+		sh.InitialStatus = sh.CurrentStatus
+		return sh, nil
+	}
+	for _, h := range iss.Changelog.Histories {
+		var dt time.Time
+		for _, item := range h.Items {
+			if strings.ToLower(strings.TrimSpace(item.Field)) != ChangelogFieldStatus {
+				continue
+			}
+			if dt.IsZero() {
+				var err error
+				if dt, err = h.CreatedTime(); err != nil {
+					return sh, fmt.Errorf("invalid changelog time for issue (%s): %w", sh.Key, err)
+				}
+			}
+			sh.Transitions = append(sh.Transitions, StatusTransition{
+				From:     item.FromString,
+				FromID:   changelogItemValueString(item.From),
+				To:       item.ToString,
+				ToID:     changelogItemValueString(item.To),
+				Author:   h.Author.DisplayName,
+				AuthorID: userID(h.Author),
+				Time:     dt})
+		}
+	}
+	sort.SliceStable(sh.Transitions, func(i, j int) bool {
+		return sh.Transitions[i].Time.Before(sh.Transitions[j].Time)
+	})
+	if len(sh.Transitions) > 0 {
+		sh.InitialStatus = sh.Transitions[0].From
+	} else {
+		sh.InitialStatus = sh.CurrentStatus
+	}
+	return sh, nil
+}
+
+func changelogItemValueString(v any) string {
+	if v == nil {
+		return ""
+	}
+	return strings.TrimSpace(fmt.Sprintf("%v", v))
+}
+
+func userID(u jira.User) string {
+	if u.AccountID != "" {
+		return u.AccountID
+	}
+	return u.Name
+}
+
+// Periods returns the time spent in each status in order, starting at issue creation. The last period
+// is the current status and ends at now.
+func (sh StatusHistory) Periods(now time.Time) []StatusPeriod {
+	var periods []StatusPeriod
+	status := sh.InitialStatus
+	start := sh.Created
+	if start.IsZero() && len(sh.Transitions) > 0 {
+		start = sh.Transitions[0].Time
+	}
+	for _, tr := range sh.Transitions {
+		periods = append(periods, StatusPeriod{
+			Status:   status,
+			Start:    start,
+			End:      tr.Time,
+			Duration: tr.Time.Sub(start)})
+		status = tr.To
+		start = tr.Time
+	}
+	if !start.IsZero() && now.Before(start) {
+		now = start
+	}
+	periods = append(periods, StatusPeriod{
+		Status:   status,
+		Start:    start,
+		End:      now,
+		Duration: now.Sub(start),
+		Current:  true})
+	return periods
+}
+
+// TimeInStatus returns the total time spent in each status, including the current status up to now.
+func (sh StatusHistory) TimeInStatus(now time.Time) map[string]time.Duration {
+	out := map[string]time.Duration{}
+	for _, p := range sh.Periods(now) {
+		out[p.Status] += p.Duration
+	}
+	return out
+}
+
+// FirstTransitionTo returns the first transition into any of the supplied statuses.
+func (sh StatusHistory) FirstTransitionTo(statuses ...string) (StatusTransition, bool) {
+	for _, tr := range sh.Transitions {
+		for _, s := range statuses {
+			if strings.EqualFold(tr.To, s) {
+				return tr, true
+			}
+		}
+	}
+	return StatusTransition{}, false
+}
+
+// LastTransitionTo returns the last transition into any of the supplied statuses.
+func (sh StatusHistory) LastTransitionTo(statuses ...string) (StatusTransition, bool) {
+	for i := len(sh.Transitions) - 1; i >= 0; i-- {
+		for _, s := range statuses {
+			if strings.EqualFold(sh.Transitions[i].To, s) {
+				return sh.Transitions[i], true
+			}
+		}
+	}
+	return StatusTransition{}, false
+}
+
+// StatusHistory returns the issue's status history. `hasChangelog` is false if the
+// issue was retrieved without its changelog, in which case the history has no transitions.
+func (im *IssueMore) StatusHistory() (sh StatusHistory, hasChangelog bool, err error) {
+	sh, err = NewStatusHistory(im.Issue)
+	return sh, im.Issue != nil && im.Issue.Changelog != nil, err
+}
+
+// StatusHistories returns the status history for each issue with a changelog, by issue key.
+// Use `IssueService.IssuesSetLoadChangelogs` to retrieve missing or truncated changelogs first.
+func (set *IssuesSet) StatusHistories() (map[string]StatusHistory, error) {
+	out := map[string]StatusHistory{}
+	for key, iss := range set.Items {
+		if iss.Changelog == nil {
+			continue
+		}
+		sh, err := NewStatusHistory(&iss)
+		if err != nil {
+			return out, err
+		}
+		out[key] = sh
+	}
+	return out, nil
+}
@@ -0,0 +1,93 @@
+package rest
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"testing"
+	"time"
+
+	jira "github.com/andygrunwald/go-jira"
+)
+
+func statusHistoryTestIssue() *jira.Issue {
+	created := time.Date(2026, 1, 1, 9, 0, 0, 0, time.UTC)
+	return &jira.Issue{
+		Key: "ABC-1",
+		Fields: &jira.IssueFields{
+			Created: jira.Time(created),
+			Status:  &jira.Status{Name: "Done"}},
+		Changelog: &jira.Changelog{Histories: []jira.ChangelogHistory{
+			{Created: "2026-01-04T09:00:00.000+0000", Author: jira.User{DisplayName: "Bob", AccountID: "b1"},
+				Items: []jira.ChangelogItems{{Field: "status", FromString: "In Progress", ToString: "Done", From: "3", To: "10001"}}},
+			{Created: "2026-01-02T09:00:00.000+0000", Author: jira.User{DisplayName: "Alice", AccountID: "a1"},
+				Items: []jira.ChangelogItems{{Field: "assignee", ToString: "Alice"}, {Field: "status", FromString: "To Do", ToString: "In Progress"}}},
+		}}}
+}
+
+func TestStatusHistory(t *testing.T) {
+	im := NewIssueMore(statusHistoryTestIssue())
+	sh, hasChangelog, err := im.StatusHistory()
+	if err != nil {
+		t.Fatalf("IssueMore.StatusHistory() error = %v", err)
+	} else if !hasChangelog || len(sh.Transitions) != 2 {
+		t.Fatalf("IssueMore.StatusHistory() mismatch: want (changelog, 2 transitions), got (%v, %d)", hasChangelog, len(sh.Transitions))
+	}
+	if tr := sh.Transitions[0]; sh.InitialStatus != "To Do" || tr.To != "In Progress" || tr.Author != "Alice" || tr.AuthorID != "a1" {
+		t.Errorf("StatusHistory.Transitions[0] mismatch: want (To Do -> In Progress by Alice), got (%s -> %s by %s)", tr.From, tr.To, tr.Author)
+	}
+	if tr := sh.Transitions[1]; tr.FromID != "3" || tr.ToID != "10001" {
+		t.Errorf("StatusHistory.Transitions[1] ids mismatch: want (3, 10001), got (%s, %s)", tr.FromID, tr.ToID)
+	}
+
+	now := time.Date(2026, 1, 5, 9, 0, 0, 0, time.UTC)
+	day := 24 * time.Hour
+	want := map[string]time.Duration{"To Do": day, "In Progress": 2 * day, "Done": day}
+	got := sh.TimeInStatus(now)
+	for status, d := range want {
+		if got[status] != d {
+			t.Errorf("StatusHistory.TimeInStatus() [%s] mismatch: want (%v), got (%v)", status, d, got[status])
+		}
+	}
+	if periods := sh.Periods(now); len(periods) != 3 || !periods[2].Current || periods[2].Status != "Done" {
+		t.Errorf("StatusHistory.Periods() mismatch: want (3 periods, current Done), got (%v)", periods)
+	}
+}
+
+func TestChangelogPaging(t *testing.T) {
+	const total = 150
+	var calls int
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		calls++
+		if r.URL.Path != "/rest/api/2/issue/ABC-1/changelog" {
+			t.Errorf("Changelog() path mismatch: want (/rest/api/2/issue/ABC-1/changelog), got (%s)", r.URL.Path)
+		}
+		startAt, _ := strconv.Atoi(r.URL.Query().Get("startAt"))
+		var values []jira.ChangelogHistory
+		for i := startAt; i < min(startAt+changelogPageSize, total); i++ {
+			values = append(values, jira.ChangelogHistory{Id: fmt.Sprintf("%d", i)})
+		}
+		w.Header().Set("Content-Type", "application/json")
+		if err := json.NewEncoder(w).Encode(changelogPage{
+			StartAt: startAt, Total: total, IsLast: startAt+len(values) >= total, Values: values}); err != nil {
+			t.Errorf("failed to encode changelog page: %v", err)
+		}
+	}))
+	defer server.Close()
+
+	jiraClient, err := jira.NewClient(nil, server.URL)
+	if err != nil {
+		t.Fatalf("failed to create jira client: %v", err)
+	}
+	svc := NewIssueService(&Client{JiraClient: jiraClient})
+	cl, err := svc.Changelog(context.Background(), "ABC-1")
+	if err != nil {
+		t.Fatalf("IssueService.Changelog() error = %v", err)
+	}
+	if len(cl.Histories) != total || calls != 2 {
+		t.Errorf("IssueService.Changelog() mismatch: want (%d histories, 2 calls), got (%d, %d)", total, len(cl.Histories), calls)
+	}
+}
@@ -59,8 +59,8 @@ func (svc *IssueService) GetTransitions(ctx context.Context, id string, expandTr
 		var result TransitionsAPIResponse
 		if resp, err := svc.Client.simpleClient.Do(ctx, sr); err != nil {
 			return nil, nil, err
-		} else if resp.StatusCode > 299 {
-			return nil, nil, fmt.Errorf("bad api response status code (%d)", resp.StatusCode)
+		} else if err := CheckResponse(resp); err != nil {
+			return nil, &jira.Response{Response: resp}, err
 		} else if b, err := io.ReadAll(resp.Body); err != nil {
 			return nil, nil, err
 		} else {
@@ -70,7 +70,7 @@ func (svc *IssueService) GetTransitions(ctx context.Context, id string, expandTr
 	} else {
 		txnsSDK, resp, err := svc.Client.JiraClient.Issue.GetTransitionsWithContext(ctx, id)
 		if err != nil {
-			return nil, resp, err
+			return nil, resp, JiraResponseError(resp, err)
 		}
 		txns := Transitions{}
 		txns.AddTransitionsSDK(txnsSDK)
@@ -97,9 +97,9 @@ func (svc *IssueService) DoTransitions(ctx context.Context, issueIDs []string, o
 	for _, issueID := range issueIDs {
 		issue, resp, err := svc.Client.JiraClient.Issue.Get(issueID, nil)
 		if err != nil {
-			return err
+			return JiraResponseError(resp, err)
 		} else if resp.StatusCode > 299 {
-			return fmt.Errorf("bad api response status code (%d)", resp.StatusCode)
+			return JiraResponseError(resp, nil)
 		}
 		im := NewIssueMore(issue)
 		status := im.Status()
@@ -123,9 +123,9 @@ func (svc *IssueService) DoTransitions(ctx context.Context, issueIDs []string, o
 func (svc *IssueService) DoTransitionWithNameAndPayload(ctx context.Context, issueID string, issue *jira.Issue, updateTransitionName string, payload *TransitionPayload) error {
 	if issue == nil {
 		if issueTry, resp, err := svc.Client.JiraClient.Issue.Get(issueID, nil); err != nil {
-			return err
+			return JiraResponseError(resp, err)
 		} else if resp.StatusCode > 299 {
-			return fmt.Errorf("bad api response status code (%d)", resp.StatusCode)
+			return JiraResponseError(resp, nil)
 		} else {
 			issue = issueTry
 		}
@@ -139,7 +139,7 @@ func (svc *IssueService) DoTransitionWithNameAndPayload(ctx context.Context, iss
 	if err != nil {
 		return err
 	} else if resp.StatusCode > 299 {
-		return fmt.Errorf("bad api response status code (%d)", resp.StatusCode)
+		return JiraResponseError(resp, nil)
 	}
 	issTxnMeta.PossibleTransitionNames = possibleTxns.Names()
 	wantTxn, err := possibleTxns.GetByName(updateTransitionName)
@@ -149,15 +149,15 @@ func (svc *IssueService) DoTransitionWithNameAndPayload(ctx context.Context, iss
 	if payload != nil {
 		payload.Transition.ID = wantTxn.ID
 		if resp, err = svc.Client.JiraClient.Issue.DoTransitionWithPayloadWithContext(ctx, issueID, *payload); err != nil {
-			return errorsutil.Wrapf(err, "meta (%s)", issTxnMeta.String())
+			return errorsutil.Wrapf(JiraResponseError(resp, err), "meta (%s)", issTxnMeta.String())
 		} else if resp.StatusCode > 299 {
-			return fmt.Errorf("bad api response status code (%d)", resp.StatusCode)
+			return errorsutil.Wrapf(JiraResponseError(resp, nil), "meta (%s)", issTxnMeta.String())
 		}
 	} else {
 		if resp, err = svc.Client.JiraClient.Issue.DoTransitionWithContext(ctx, issueID, wantTxn.ID); err != nil {
-			return err
+			return JiraResponseError(resp, err)
 		} else if resp.StatusCode > 299 {
-			return fmt.Errorf("bad api response status code (%d)", resp.StatusCode)
+			return JiraResponseError(resp, nil)
 		}
 	}
 
@@ -29,6 +29,8 @@ type StageConfig struct {
 	MetaStageDone                string
 	MetaStagePrefixIn            string
 	MetaStagePrefixReadyFor      string
+	MetaStages                   []string // Override auto-build order, e.g. with board columns
+	MetaStagesInProgress         []string // Override `InProgressNames()`
 }
 
 func NewStageConfigEmpty() *StageConfig {
@@ -101,6 +103,22 @@ func (ss *StageConfig) DoneName() string {
 	return buildMetaStageName(ss.MetaStageDone, "", ss.StageNameDone)
 }
 
+// InProgressNames returns the meta stages where work is underway: design, development,
+// testing, deployment and review. Planning is not considered in progress. `MetaStagesInProgress`
+// overrides this when set.
+func (ss *StageConfig) InProgressNames() []string {
+	if len(ss.MetaStagesInProgress) > 0 {
+		return stringsutil.SliceCondenseSpace(ss.MetaStagesInProgress, true, false)
+	}
+	return stringsutil.SliceCondenseSpace([]string{
+		ss.InDesignName(),
+		ss.InDevelopmentName(),
+		ss.InTestingName(),
+		ss.InDeploymentName(),
+		ss.InReviewName(),
+	}, true, false)
+}
+
 func (ss *StageConfig) Exists(stageName string) bool {
 	m := ss.Map()
 	_, ok := m[stageName]
@@ -117,7 +135,11 @@ func (ss *StageConfig) TrimSpaceNames() {
 	ss.StageNameDone = strings.TrimSpace(ss.StageNameDone)
 }
 
+// Order returns the meta stages in workflow order. `MetaStages` overrides this when set.
 func (ss *StageConfig) Order() []string {
+	if len(ss.MetaStages) > 0 {
+		return stringsutil.SliceCondenseSpace(ss.MetaStages, true, false)
+	}
 	return stringsutil.SliceCondenseSpace([]string{
 		ss.ReadyForPlanningName(),
 		ss.InPlanningName(),
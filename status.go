@@ -87,6 +87,20 @@ func (ss *StatusCategoryConfig) StatusesDone() []string { // not backlog
 	}
 }
 
+// StatusesInProgress returns the statuses mapped to any of the `StageConfig.InProgressNames()` meta stages.
+func (ss *StatusCategoryConfig) StatusesInProgress() []string {
+	return ss.StatusesForMetaStages(ss.StageConfig.InProgressNames()...)
+}
+
+// StatusesForMetaStages returns the statuses mapped to any of the supplied meta stages.
+func (ss *StatusCategoryConfig) StatusesForMetaStages(metaStages ...string) []string {
+	var statuses []string
+	for _, metaStage := range metaStages {
+		statuses = append(statuses, ss.StatusesForMetaStage(metaStage)...)
+	}
+	return stringsutil.SliceCondenseSpace(statuses, true, true)
+}
+
 func (ss *StatusCategoryConfig) StatusesForMetaStage(metaStatus string) []string {
 	var statuses []string
 	for k, v := range ss.Map {
@@ -0,0 +1,176 @@
+package gojira
+
+import (
+	"errors"
+	"fmt"
+	"os"
+	"sort"
+	"strings"
+
+	"gopkg.in/yaml.v3"
+)
+
+// StatusConfigFile is the YAML representation of a `StatusCategoryConfig`, listing meta stages in
+// workflow order with the Jira statuses mapped to each. For example:
+//
+//	stages:
+//	  - name: To Do
+//	    statuses: [Backlog, Open]
+//	  - name: In Progress
+//	    statuses: [In Progress, In Review]
+//	  - name: Done
+//	    statuses: [Closed, Done]
+//	inProgress: [In Progress]
+//	done: Done
+//
+// `done` defaults to the last stage. `inProgress` defaults to the stages between the first stage
+// and `done`.
+type StatusConfigFile struct {
+	Stages     []StatusConfigStage `yaml:"stages" json:"stages"`
+	InProgress []string            `yaml:"inProgress,omitempty" json:"inProgress,omitempty"`
+	Done       string              `yaml:"done,omitempty" json:"done,omitempty"`
+}
+
+// StatusConfigStage is a meta stage, such as a board column, and its Jira statuses.
+type StatusConfigStage struct {
+	Name     string   `yaml:"name" json:"name"`
+	Statuses []string `yaml:"statuses" json:"statuses"`
+}
+
+// StatusConfigFile returns the file representation. Meta stages with mapped statuses that are
+// not in `StageConfig.Order()` are appended in alphabetical order. If `StageConfig.MetaStages` is
+// not set, meta stages without statuses are omitted.
+func (ss *StatusCategoryConfig) StatusConfigFile() StatusConfigFile {
+	order := ss.StageConfig.Order()
+	inOrder := map[string]bool{}
+	for _, name := range order {
+		inOrder[name] = true
+	}
+	var extra []string
+	for metaStage := range ss.MapMetaStageToStatuses() {
+		if !inOrder[metaStage] {
+			extra = append(extra, metaStage)
+		}
+	}
+	sort.Strings(extra)
+	out := StatusConfigFile{}
+	kept := map[string]bool{}
+	for _, name := range append(order, extra...) {
+		statuses := ss.StatusesForMetaStage(name)
+		if len(statuses) == 0 && len(ss.StageConfig.MetaStages) == 0 {
+			continue
+		}
+		kept[name] = true
+		out.Stages = append(out.Stages, StatusConfigStage{Name: name, Statuses: statuses})
+	}
+	for _, name := range ss.StageConfig.InProgressNames() {
+		if kept[name] {
+			out.InProgress = append(out.InProgress, name)
+		}
+	}
+	if done := ss.StageConfig.DoneName(); kept[done] {
+		out.Done = done
+	}
+	return out
+}
+
+// StatusCategoryConfig builds a `StatusCategoryConfig` whose `StageConfig` uses the file's stage
+// order, in progress stages and done stage.
+func (f StatusConfigFile) StatusCategoryConfig() (*StatusCategoryConfig, error) {
+	if len(f.Stages) == 0 {
+		return nil, errors.New("status config has no stages")
+	}
+	var names []string
+	seen := map[string]bool{}
+	for _, stage := range f.Stages {
+		name := strings.TrimSpace(stage.Name)
+		if name == "" {
+			return nil, errors.New("status config stage name cannot be empty")
+		} else if seen[name] {
+			return nil, fmt.Errorf("status config stage is duplicated (%s)", name)
+		}
+		seen[name] = true
+		names = append(names, name)
+	}
+	done := strings.TrimSpace(f.Done)
+	if done == "" {
+		done = names[len(names)-1]
+	} else if !seen[done] {
+		return nil, fmt.Errorf("status config done stage not found (%s)", done)
+	}
+	inProgress := f.InProgress
+	if len(inProgress) == 0 {
+		for _, name := range names[1:] {
+			if name == done {
+				break
+			}
+			inProgress = append(inProgress, name)
+		}
+	}
+	for _, name := range inProgress {
+		if !seen[strings.TrimSpace(name)] {
+			return nil, fmt.Errorf("status config in progress stage not found (%s)", name)
+		}
+	}
+
+	scc := NewStatusConfig(StageConfig{
+		MetaStages:           names,
+		MetaStagesInProgress: inProgress,
+		MetaStageDone:        done})
+	for i, stage := range f.Stages {
+		for _, status := range stage.Statuses {
+			status = strings.TrimSpace(status)
+			if status == "" {
+				continue
+			} else if metaStage, ok := scc.Map[status]; ok && metaStage != names[i] {
+				return nil, fmt.Errorf("status config status is mapped to more than one stage (%s)", status)
+			} else if err := scc.Add(status, names[i]); err != nil {
+				return nil, err
+			}
+		}
+	}
+	return &scc, nil
+}
+
+// MarshalYAML implements `yaml.Marshaler` using `StatusConfigFile`.
+func (ss StatusCategoryConfig) MarshalYAML() (any, error) {
+	return ss.StatusConfigFile(), nil
+}
+
+// UnmarshalYAML implements `yaml.Unmarshaler` using `StatusConfigFile`.
+func (ss *StatusCategoryConfig) UnmarshalYAML(node *yaml.Node) error {
+	var f StatusConfigFile
+	if err := node.Decode(&f); err != nil {
+		return err
+	}
+	scc, err := f.StatusCategoryConfig()
+	if err != nil {
+		return err
+	}
+	*ss = *scc
+	return nil
+}
+
+// ReadFileStatusCategoryConfig reads a `StatusCategoryConfig` from a YAML file.
+func ReadFileStatusCategoryConfig(filename string) (*StatusCategoryConfig, error) {
+	b, err := os.ReadFile(filename)
+	if err != nil {
+		return nil, err
+	}
+	scc := &StatusCategoryConfig{}
+	if err := yaml.Unmarshal(b, scc); err != nil {
+		return nil, fmt.Errorf("invalid status config file (%s): %w", filename, err)
+	} else if len(scc.StageConfig.MetaStages) == 0 {
+		return nil, fmt.Errorf("status config file has no stages (%s)", filename)
+	}
+	return scc, nil
+}
+
+// WriteFileYAML writes the `StatusCategoryConfig` as a YAML file.
+func (ss *StatusCategoryConfig) WriteFileYAML(filename string, perm os.FileMode) error {
+	b, err := yaml.Marshal(ss)
+	if err != nil {
+		return err
+	}
+	return os.WriteFile(filename, b, perm)
+}
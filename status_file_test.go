@@ -0,0 +1,82 @@
+package gojira
+
+import (
+	"slices"
+	"testing"
+
+	"gopkg.in/yaml.v3"
+)
+
+var statusConfigFileTests = []struct {
+	yaml       string
+	order      []string
+	inProgress []string
+	done       string
+	metaStages map[string]string
+}{
+	{`stages:
+  - name: To Do
+    statuses: [Backlog, Open]
+  - name: In Progress
+    statuses: [In Progress]
+  - name: Review
+    statuses: [In Review]
+  - name: Done
+    statuses: [Done, Closed]
+`, []string{"To Do", "In Progress", "Review", "Done"}, []string{"In Progress", "Review"}, "Done",
+		map[string]string{"Open": "To Do", "In Review": "Review", "Closed": "Done"}},
+	{`stages:
+  - name: Backlog
+    statuses: [Backlog]
+  - name: Selected
+    statuses: [Selected]
+  - name: Doing
+    statuses: [Doing]
+  - name: Shipped
+    statuses: [Shipped]
+  - name: Archived
+    statuses: []
+inProgress: [Doing]
+done: Shipped
+`, []string{"Backlog", "Selected", "Doing", "Shipped", "Archived"}, []string{"Doing"}, "Shipped",
+		map[string]string{"Selected": "Selected", "Shipped": "Shipped"}},
+}
+
+func TestStatusConfigFile(t *testing.T) {
+	for _, tt := range statusConfigFileTests {
+		scc := StatusCategoryConfig{}
+		if err := yaml.Unmarshal([]byte(tt.yaml), &scc); err != nil {
+			t.Fatalf("yaml.Unmarshal() error: %v", err)
+		}
+		for i := 0; i < 2; i++ {
+			if order := scc.StageConfig.Order(); !slices.Equal(order, tt.order) {
+				t.Errorf("StageConfig.Order() mismatch: want (%v), got (%v)", tt.order, order)
+			}
+			if inProgress := scc.StageConfig.InProgressNames(); !slices.Equal(inProgress, tt.inProgress) {
+				t.Errorf("StageConfig.InProgressNames() mismatch: want (%v), got (%v)", tt.inProgress, inProgress)
+			}
+			if done := scc.StageConfig.DoneName(); done != tt.done {
+				t.Errorf("StageConfig.DoneName() mismatch: want (%s), got (%s)", tt.done, done)
+			}
+			for status, metaStage := range tt.metaStages {
+				if try := scc.MetaStage(status); try != metaStage {
+					t.Errorf("StatusCategoryConfig.MetaStage(%s) mismatch: want (%s), got (%s)", status, metaStage, try)
+				}
+			}
+			// round trip
+			b, err := yaml.Marshal(scc)
+			if err != nil {
+				t.Fatalf("yaml.Marshal() error: %v", err)
+			}
+			scc = StatusCategoryConfig{}
+			if err := yaml.Unmarshal(b, &scc); err != nil {
+				t.Fatalf("yaml.Unmarshal() round trip error: %v", err)
+			}
+		}
+	}
+
+	bad := "stages:\n  - name: A\n    statuses: [Open]\n  - name: B\n    statuses: [Open]\n"
+	if err := yaml.Unmarshal([]byte(bad), &StatusCategoryConfig{}); err == nil {
+		t.Errorf("yaml.Unmarshal() mismatch: want error for duplicate status, got nil")
+	}
+}